@@ -0,0 +1,253 @@
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+// Airlift (Trino/Presto approx_set, HyperLogLog type) interoperability, format version 2.
+//
+// Layout (little endian), sparse:
+//
+//	1 byte: format, 2.
+//	1 byte: p (1 to 16).
+//	2 bytes: number of entries.
+//	4 bytes per entry, sorted: the top 26 bits of the hash (bucket), followed by 6 bits with
+//	the number of leading zeros of the rest of the hash.
+//
+// dense:
+//
+//	1 byte: format, 3.
+//	1 byte: p.
+//	1 byte: baseline, the smallest register value.
+//	2^p / 2 bytes: 4 bit deltas from the baseline (the even register in the high nibble).
+//	2 bytes: number of overflows.
+//	2 bytes per overflow: register index (sorted).
+//	1 byte per overflow: value - baseline - 15, for registers with delta 15.
+//
+// Like HyperLogLog++, Airlift takes the register index from the top bits of the hash:
+// the standard layout (see SetStandardLayout), which maps as is.
+// For the default layout, RemapAirliftHash converts a hash, so go-hll puts it into the same register.
+// Sparse entries map to a sparse HLL (a go-hll hash for each), dense to a dense one.
+
+const (
+	airliftSparse      = 2
+	airliftDense       = 3
+	airliftMaxP        = 16
+	airliftBucketBits  = 26
+	airliftValueBits   = 6
+	airliftMaxDelta    = 15
+	airliftSparseLimit = 1<<16 - 1
+)
+
+// RemapAirliftHash converts an Airlift hash into a go-hll hash for an HLL with precision p,
+// keeping the register index and value. It is the same as RemapZetaHash.
+func RemapAirliftHash(hash uint64, p int) uint64 {
+	return RemapZetaHash(hash, p)
+}
+
+// AirliftHash returns the hash of b as HyperLogLog.add(Slice) (or approx_set on a varchar) computes it
+// (the first half of Murmur3 with seed 0), remapped for an HLL with precision p (in the default layout;
+// the standard layout takes the Murmur3 hash as it is).
+// approx_set on a bigint hashes its 8 little endian bytes.
+func AirliftHash(b []byte, p int) uint64 {
+	h, _ := Murmur3(b, 0)
+	return RemapAirliftHash(h, p)
+}
+
+// airliftEntry returns the sparse entry for an Airlift hash.
+func airliftEntry(hash uint64) uint32 {
+	lz := bits.LeadingZeros64(hash<<airliftBucketBits | 1<<(airliftBucketBits-1))
+	return uint32(hash>>(64-airliftBucketBits))<<airliftValueBits | uint32(lz)
+}
+
+// airliftEntryHash returns an Airlift hash with sparse entry e.
+func airliftEntryHash(e uint32) (uint64, error) {
+	lz := int(e & (1<<airliftValueBits - 1))
+	if lz > 64-airliftBucketBits {
+		return 0, errors.New("airlift sparse entry is out of range")
+	}
+	hash := uint64(e>>airliftValueBits) << (64 - airliftBucketBits)
+	if lz < 64-airliftBucketBits {
+		hash |= 1 << uint(64-airliftBucketBits-1-lz)
+	}
+	return hash, nil
+}
+
+// DecodeAirlift converts a serialized Airlift HyperLogLog (version 2) into a new HLL with the same precision.
+// The HLL has the default layout: add AirliftHash hashes to it.
+func DecodeAirlift(b []byte) (HLL, error) {
+	return decodeAirlift(b, false)
+}
+
+// DecodeAirliftStandard is DecodeAirlift into an HLL with the standard layout (see SetStandardLayout):
+// Airlift hashes are added as they are.
+func DecodeAirliftStandard(b []byte) (HLL, error) {
+	return decodeAirlift(b, true)
+}
+
+func decodeAirlift(b []byte, standard bool) (HLL, error) {
+	if len(b) < 2 {
+		return nil, errors.New("airlift hll is too short")
+	}
+	p := int(b[1])
+	if p > airliftMaxP {
+		return nil, errors.New("airlift p must be at most 16")
+	}
+	s, err := SizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	h := make(HLL, s)
+	if standard {
+		h.SetStandardLayout()
+	}
+	switch b[0] {
+	case airliftSparse:
+		if len(b) < 4 {
+			return nil, errors.New("airlift hll is too short")
+		}
+		n := int(binary.LittleEndian.Uint16(b[2:]))
+		if len(b) != 4+4*n {
+			return nil, errors.New("airlift sparse hll has wrong size")
+		}
+		for i := 0; i < n; i++ {
+			hash, err := airliftEntryHash(binary.LittleEndian.Uint32(b[4+4*i:]))
+			if err != nil {
+				return nil, err
+			}
+			if !standard {
+				hash = RemapAirliftHash(hash, p)
+			}
+			h.Add(hash)
+		}
+		h.EstimateCardinality() // Sort.
+		return h, nil
+	case airliftDense:
+		d := Dense(h[8:])
+		m := d.m()
+		if len(b) < 3+m/2+2 {
+			return nil, errors.New("airlift dense hll is too short")
+		}
+		baseline := int(b[2])
+		deltas := b[3 : 3+m/2]
+		n := int(binary.LittleEndian.Uint16(b[3+m/2:]))
+		overflows := b[3+m/2+2:]
+		if len(overflows) != 3*n {
+			return nil, errors.New("airlift dense hll has wrong size")
+		}
+		maxValue := 64 - p + 1
+		for i := 0; i < m; i++ {
+			v := baseline + int(deltas[i/2]>>(4*uint(^i&1))&15)
+			if v > maxValue {
+				return nil, errors.New("airlift register is out of range")
+			}
+			d.set(i, byte(v))
+		}
+		for k := 0; k < n; k++ {
+			i := int(binary.LittleEndian.Uint16(overflows[2*k:]))
+			if i >= m || int(d.get(i)) != baseline+airliftMaxDelta {
+				return nil, errors.New("airlift overflow for a regular register")
+			}
+			v := int(d.get(i)) + int(overflows[2*n+k])
+			if v > maxValue {
+				return nil, errors.New("airlift register is out of range")
+			}
+			d.set(i, byte(v))
+		}
+		h[0] |= 128 + 64 // dirty + dense
+		return h, nil
+	default:
+		return nil, errors.New("unsupported airlift hll format")
+	}
+}
+
+// EncodeAirlift converts an HLL (with p up to 16, either layout) into a serialized Airlift HyperLogLog,
+// that Trino can merge and count.
+// A sparse HLL is written as sparse unless the dense serialization is smaller.
+func EncodeAirlift(h HLL) ([]byte, error) {
+	if err := h.IsValid(); err != nil {
+		return nil, err
+	}
+	d := Dense(h[8:])
+	p := int(d.p())
+	if p > airliftMaxP {
+		return nil, errors.New("airlift p must be at most 16")
+	}
+	m := d.m()
+	if h[0]&(1<<6) == 0 {
+		hashes := sparse(h).uniqueHashes()
+		entries := make([]uint32, len(hashes))
+		for i, x := range hashes {
+			if !h.IsStandardLayout() {
+				x = bits.RotateLeft64(x, -p)
+			}
+			entries[i] = airliftEntry(x)
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i] < entries[j] })
+		// Keep the largest number of leading zeros for every bucket.
+		n := 0
+		for _, e := range entries {
+			if n > 0 && e>>airliftValueBits == entries[n-1]>>airliftValueBits {
+				entries[n-1] = e
+				continue
+			}
+			entries[n] = e
+			n++
+		}
+		entries = entries[:n]
+		if n <= airliftSparseLimit && 4*n < m/2 {
+			b := make([]byte, 4+4*n)
+			b[0] = airliftSparse
+			b[1] = byte(p)
+			binary.LittleEndian.PutUint16(b[2:], uint16(n))
+			for i, e := range entries {
+				binary.LittleEndian.PutUint32(b[4+4*i:], e)
+			}
+			return b, nil
+		}
+		d = make(Dense, len(h)-8)
+		mergeIntoDense(d, sparse(h))
+	}
+
+	maxValue := byte(64 - p + 1)
+	register := func(i int) byte {
+		if v := d.get(i); v < maxValue {
+			return v
+		}
+		return maxValue
+	}
+	baseline := maxValue
+	for i := 0; i < m; i++ {
+		if v := register(i); v < baseline {
+			baseline = v
+		}
+	}
+	var overflows []int
+	for i := 0; i < m; i++ {
+		if register(i)-baseline > airliftMaxDelta {
+			overflows = append(overflows, i)
+		}
+	}
+	b := make([]byte, 3+m/2+2+3*len(overflows))
+	b[0] = airliftDense
+	b[1] = byte(p)
+	b[2] = baseline
+	deltas := b[3 : 3+m/2]
+	for i := 0; i < m; i++ {
+		delta := register(i) - baseline
+		if delta > airliftMaxDelta {
+			delta = airliftMaxDelta
+		}
+		deltas[i/2] |= delta << (4 * uint(^i&1))
+	}
+	binary.LittleEndian.PutUint16(b[3+m/2:], uint16(len(overflows)))
+	o := b[3+m/2+2:]
+	for k, i := range overflows {
+		binary.LittleEndian.PutUint16(o[2*k:], uint16(i))
+		o[2*len(overflows)+k] = register(i) - baseline - airliftMaxDelta
+	}
+	return b, nil
+}
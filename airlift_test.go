@@ -0,0 +1,251 @@
+package hll
+
+import (
+	"bytes"
+	"encoding/hex"
+	"log"
+	"testing"
+)
+
+// The golden vectors (sparse and dense) are NOT output of Trino or airlift: they are derived by hand
+// from the layout (see airlift.go). Real sketches go to testdata/airlift (see TestAirliftRealSketches).
+
+const (
+	airliftGoldenSparse = "020b0200" + "65000000" + "26000080"
+	airliftGoldenDense  = "030402" + "01000f000000000f" + // Format, p, baseline, deltas.
+		"0200" + "05000f00" + "032c" // Overflows: count, buckets, values.
+)
+
+func TestAirliftGoldenSparse(t *testing.T) {
+	s, err := SizeByP(11)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	// Bucket 1<<25, the rest of the hash is zero: 38 leading zeros. Entry 0x80000026.
+	h.Add(RemapAirliftHash(0x8000000000000000, 11))
+	// Bucket 1, 37 zeros after it. Entry 1<<6 | 37 = 0x65.
+	h.Add(RemapAirliftHash(0x0000004000000001, 11))
+	b, err := EncodeAirlift(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b) != airliftGoldenSparse {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	g, err := DecodeAirlift(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsSparse() || g.EstimateCardinality() != 2 {
+		t.Fatal(g.EstimateCardinality())
+	}
+	if !bytes.Equal(toDenseHLL(g), toDenseHLL(h)) {
+		t.Fatal("registers differ")
+	}
+	if c, err := EncodeAirlift(g); err != nil || !bytes.Equal(c, b) {
+		t.Fatal("round trip differs", err)
+	}
+}
+
+func TestAirliftGoldenDense(t *testing.T) {
+	s, err := SizeByP(4)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	h[0] = 128 + 64
+	d := Dense(h[8:])
+	for i := 0; i < 16; i++ {
+		d.set(i, 2)
+	}
+	d.set(1, 3)
+	d.set(5, 20)  // Delta 18: overflow 3.
+	d.set(15, 61) // Delta 59: overflow 44.
+	b, err := EncodeAirlift(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b) != airliftGoldenDense {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	g, err := DecodeAirlift(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := Dense(g[8:]); !bytes.Equal(g[8:], h[8:]) || d.get(0) != 2 || d.get(1) != 3 || d.get(5) != 20 || d.get(15) != 61 {
+		t.Fatal("registers differ")
+	}
+	if c, err := EncodeAirlift(g); err != nil || !bytes.Equal(c, b) {
+		t.Fatal("round trip differs", err)
+	}
+}
+
+// TestAirliftRealSketches checks the sketches in testdata/airlift: approx_set (HyperLogLog.serialize) output,
+// sparse or dense V2, hex encoded, one per .hex file. They must decode into a valid HLL and encode back byte for byte.
+func TestAirliftRealSketches(t *testing.T) {
+	for _, b := range realSketches(t, "airlift") {
+		h, err := DecodeAirlift(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := h.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if c, err := EncodeAirlift(h); err != nil || !bytes.Equal(c, b) {
+			t.Fatal("round trip differs", err)
+		}
+	}
+}
+
+// TestAirliftGoldenStandard checks that the standard layout takes Airlift hashes and registers as they are.
+func TestAirliftGoldenStandard(t *testing.T) {
+	h := newStandard(11)
+	h.Add(0x8000000000000000)
+	h.Add(0x0000004000000001)
+	b, err := EncodeAirlift(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b) != airliftGoldenSparse {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	g, err := DecodeAirliftStandard(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsStandardLayout() || !g.IsSparse() || g.EstimateCardinality() != 2 {
+		t.Fatal(g.IsStandardLayout(), g.EstimateCardinality())
+	}
+	if !bytes.Equal(toDenseHLL(g), toDenseHLL(h)) {
+		t.Fatal("registers differ")
+	}
+
+	// The registers of TestAirliftGoldenDense.
+	h = newStandard(4)
+	h[0] |= 128 + 64
+	d := Dense(h[8:])
+	for i := 0; i < 16; i++ {
+		d.set(i, 2)
+	}
+	d.set(1, 3)
+	d.set(5, 20)
+	d.set(15, 61)
+	if b, err = EncodeAirlift(h); err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b) != airliftGoldenDense {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	if g, err = DecodeAirliftStandard(b); err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsStandardLayout() || !bytes.Equal(g[8:], h[8:]) {
+		t.Fatal("registers differ")
+	}
+}
+
+// TestAirliftStandardRoundTrip checks that the standard layout with Airlift hashes encodes
+// as the default one with remapped hashes.
+func TestAirliftStandardRoundTrip(t *testing.T) {
+	for _, p := range []int{4, 11, 16} {
+		s, err := SizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		for _, n := range []int{0, 1, 100, 1000, 100000} {
+			h, std := make(HLL, s), newStandard(p)
+			for i := 0; i < n; i++ {
+				h.Add(RemapAirliftHash(xorShift64StarRound(i+1), p))
+				std.Add(xorShift64StarRound(i + 1))
+			}
+			b, err := EncodeAirlift(h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := EncodeAirlift(std)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, c) {
+				t.Fatal(p, n, "encodings differ")
+			}
+			g, err := DecodeAirliftStandard(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := g.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if !g.IsStandardLayout() || !bytes.Equal(toDenseHLL(g)[8:], toDenseHLL(std)[8:]) {
+				t.Fatal(p, n, "registers differ")
+			}
+		}
+	}
+}
+
+func TestAirliftRoundTrip(t *testing.T) {
+	for _, p := range []int{4, 11, 16} {
+		s, err := SizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		for _, n := range []int{0, 1, 100, 1000, 100000} {
+			h := make(HLL, s)
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i + 1))
+			}
+			b, err := EncodeAirlift(h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			g, err := DecodeAirlift(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := g.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(toDenseHLL(g)[8:], toDenseHLL(h)[8:]) {
+				t.Fatal(p, n, "registers differ")
+			}
+			c, err := EncodeAirlift(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, c) {
+				t.Fatal(p, n, "encoding is not stable")
+			}
+		}
+	}
+}
+
+func TestAirliftErrors(t *testing.T) {
+	s, err := SizeByP(17)
+	if err != nil {
+		log.Panicln(err)
+	}
+	if _, err := EncodeAirlift(make(HLL, s)); err == nil {
+		t.Fatal("expected error for p = 17")
+	}
+	for _, s := range []string{
+		"",
+		"02",
+		"0211",                       // p = 17.
+		"0203",                       // p = 3.
+		"020b",                       // No entry count.
+		"020b010001",                 // Truncated entry.
+		"020b010027000000",           // 39 leading zeros.
+		"000b0000",                   // Version 1.
+		"03040201000f000000000f0200", // Truncated overflows.
+		"030402" + "01000f000000000f" + "0100" + "0400" + "01",       // Overflow for delta 0.
+		"030402" + "01000f000000000f" + "0200" + "05000500" + "0101", // Duplicate overflow.
+		"030402" + "01000f000000000f" + "0100" + "0f00" + "2d",       // Register 62.
+		"03043e" + "0000000000000000" + "0000",                       // Baseline 62.
+	} {
+		b, _ := hex.DecodeString(s)
+		if _, err := DecodeAirlift(b); err == nil {
+			t.Fatal("expected error for", s)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package hll
+
+import (
+	"encoding/binary"
+	"runtime"
+	"sync/atomic"
+)
+
+// AtomicDense is a dense HLL that is safe for concurrent use: any number of goroutines might Add, Merge
+// and EstimateCardinality at the same time. Nothing allocates.
+//
+// The registers are kept in the Dense layout (4 registers in 3 bytes), in aligned 64-bit words
+// holding 8 bytes each, little-endian: in memory (on little-endian machines) they are a Dense.
+// A register update is a compare-and-swap on the word that holds it.
+// The last register of some groups (1 in 16) straddles two words: those are updated under a sequence lock,
+// which concurrent readers of the register retry on.
+// Use Snapshot to get a regular Dense (say, to store or to Merge into an HLL).
+type AtomicDense struct {
+	words []uint64
+	m     int
+	// seqs are the sequence locks of the registers that straddle two words, by group (mod 64).
+	seqs [64]uint32
+}
+
+// straddleMask is the bits of the last register of a group (the low 2 bits of its 3 bytes), from the first byte.
+const straddleMask = 0x030303
+
+// NewAtomicDense creates an empty AtomicDense of precision p.
+// Precision (p) must be between 4 and 25 (inclusive).
+func NewAtomicDense(p int) (*AtomicDense, error) {
+	s, err := DenseSizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	return &AtomicDense{words: make([]uint64, (s+7)/8), m: 1 << uint(p)}, nil
+}
+
+// Add a hash to an HLL.
+// Returns true if cardinality esimate changed.
+func (h *AtomicDense) Add(hash uint64) bool {
+	idx := int(hash & uint64(h.m-1))
+	return h.max(idx, rho(hash))
+}
+
+// max sets register idx to v unless it is already larger.
+func (h *AtomicDense) max(idx int, v byte) bool {
+	bp := idx >> 2 * 3
+	if s := idx & 3; s != 3 {
+		w, shift := &h.words[(bp+s)>>3], uint((bp+s)&7)*8+2
+		for {
+			old := atomic.LoadUint64(w)
+			if byte(old>>shift)&63 >= v {
+				return false
+			}
+			if atomic.CompareAndSwapUint64(w, old, old&^(63<<shift)|uint64(v)<<shift) {
+				return true
+			}
+		}
+	}
+	if bp&7 > 5 {
+		return h.maxStraddling(idx, v)
+	}
+	w, shift := &h.words[bp>>3], uint(bp&7)*8
+	for {
+		old := atomic.LoadUint64(w)
+		if gather(old>>shift) >= v {
+			return false
+		}
+		if atomic.CompareAndSwapUint64(w, old, old&^(straddleMask<<shift)|spread(v)<<shift) {
+			return true
+		}
+	}
+}
+
+// maxStraddling is max for a register that straddles two words.
+// Other registers of the two words might change concurrently, but not this one: the writers hold the lock.
+func (h *AtomicDense) maxStraddling(idx int, v byte) bool {
+	if h.get(idx) >= v {
+		return false
+	}
+	bp := idx >> 2 * 3
+	shift := uint(bp&7) * 8
+	seq := &h.seqs[idx>>2&63]
+	lock(seq)
+	defer atomic.AddUint32(seq, 1)
+	lo, hi := &h.words[bp>>3], &h.words[bp>>3+1]
+	if gather(atomic.LoadUint64(lo)>>shift|atomic.LoadUint64(hi)<<(64-shift)) >= v {
+		return false
+	}
+	x := spread(v)
+	setBits(lo, straddleMask<<shift, x<<shift)
+	setBits(hi, straddleMask>>(64-shift), x>>(64-shift))
+	return true
+}
+
+// lock takes a sequence lock: makes it odd.
+func lock(seq *uint32) {
+	for {
+		if s := atomic.LoadUint32(seq); s&1 == 0 && atomic.CompareAndSwapUint32(seq, s, s+1) {
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
+// setBits sets the bits of w in mask to x.
+func setBits(w *uint64, mask, x uint64) {
+	for {
+		old := atomic.LoadUint64(w)
+		if atomic.CompareAndSwapUint64(w, old, old&^mask|x) {
+			return
+		}
+	}
+}
+
+// spread returns the last register of a group in the layout of its 3 bytes (see straddleMask).
+func spread(v byte) uint64 {
+	return uint64(v>>4) | uint64(v>>2&3)<<8 | uint64(v&3)<<16
+}
+
+// gather is the inverse of spread.
+func gather(x uint64) byte {
+	return byte(x&3)<<4 | byte(x>>8&3)<<2 | byte(x>>16&3)
+}
+
+func (h *AtomicDense) get(idx int) byte {
+	bp := idx >> 2 * 3
+	if s := idx & 3; s != 3 {
+		return byte(atomic.LoadUint64(&h.words[(bp+s)>>3])>>(uint((bp+s)&7)*8+2)) & 63
+	}
+	shift := uint(bp&7) * 8
+	if bp&7 <= 5 {
+		return gather(atomic.LoadUint64(&h.words[bp>>3]) >> shift)
+	}
+	seq := &h.seqs[idx>>2&63]
+	for {
+		if s := atomic.LoadUint32(seq); s&1 == 0 {
+			lo, hi := atomic.LoadUint64(&h.words[bp>>3]), atomic.LoadUint64(&h.words[bp>>3+1])
+			if atomic.LoadUint32(seq) == s {
+				return gather(lo>>shift | hi<<(64-shift))
+			}
+		}
+		runtime.Gosched()
+	}
+}
+
+// Merge a Dense (of the same precision) into this.
+func (h *AtomicDense) Merge(g Dense) error {
+	if g.m() != h.m {
+		return ErrSizeMismatch
+	}
+	for i := 0; i < h.m; i++ {
+		if v := g.get(i); v != 0 {
+			h.max(i, v)
+		}
+	}
+	return nil
+}
+
+// EstimateCardinality returns a cardinality estimate.
+// Concurrent Adds might or might not be accounted for.
+func (h *AtomicDense) EstimateCardinality() uint64 {
+	var V int
+	var invSum float64
+	for i := 0; i < h.m; i++ {
+		v := h.get(i)
+		invSum += lookup[v]
+		if v == 0 {
+			V++
+		}
+	}
+	return round(correctedEstimate(h.m, invSum, V))
+}
+
+// Snapshot copies the registers into dst (of the same precision), word by word.
+// Concurrent Adds might or might not be copied.
+func (h *AtomicDense) Snapshot(dst Dense) error {
+	if dst.m() != h.m {
+		return ErrSizeMismatch
+	}
+	var b [8]byte
+	for i := range h.words {
+		binary.LittleEndian.PutUint64(b[:], atomic.LoadUint64(&h.words[i]))
+		copy(dst[i*8:], b[:])
+	}
+	// A straddling register might have been copied half-updated.
+	for i := 3; i < h.m; i += 4 {
+		if i>>2*3&7 > 5 {
+			dst.set(i, h.get(i))
+		}
+	}
+	return nil
+}
+
+// Clear resets the HLL.
+// Adds that run concurrently with Clear might or might not survive it.
+func (h *AtomicDense) Clear() {
+	for i := range h.seqs {
+		lock(&h.seqs[i])
+	}
+	for i := range h.words {
+		atomic.StoreUint64(&h.words[i], 0)
+	}
+	for i := range h.seqs {
+		atomic.AddUint32(&h.seqs[i], 1)
+	}
+}
@@ -0,0 +1,104 @@
+package hll
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestAtomicDense(t *testing.T) {
+	for _, p := range []int{4, 11, 14} {
+		h, err := NewAtomicDense(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, _ := DenseSizeByP(p)
+		expected := make(Dense, s)
+		for i := 0; i < 100000; i++ {
+			expected.Add(xorShift64StarRound(i))
+		}
+		var wg sync.WaitGroup
+		done := make(chan bool)
+		go func() {
+			// No register is ever copied half-updated (larger than it ends up).
+			snap := make(Dense, s)
+			for {
+				select {
+				case <-done:
+					done <- true
+					return
+				default:
+				}
+				h.Snapshot(snap)
+				for i := 0; i < 1<<uint(p); i++ {
+					if snap.get(i) > expected.get(i) {
+						t.Error(p, i, snap.get(i), expected.get(i))
+					}
+				}
+			}
+		}()
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := g; i < 100000; i += 8 {
+					h.Add(xorShift64StarRound(i))
+					if i%1000 == 0 {
+						h.EstimateCardinality()
+					}
+				}
+			}(g)
+		}
+		wg.Wait()
+		done <- true
+		<-done
+		snap := make(Dense, s)
+		if err := h.Snapshot(snap); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(snap, expected) {
+			t.Fatal(p, "snapshot differs")
+		}
+		if h.EstimateCardinality() != expected.EstimateCardinality() {
+			t.Fatal(p, h.EstimateCardinality(), expected.EstimateCardinality())
+		}
+
+		h.Clear()
+		if h.EstimateCardinality() != 0 {
+			t.Fatal(h.EstimateCardinality())
+		}
+		if err := h.Merge(expected); err != nil {
+			t.Fatal(err)
+		}
+		if err := h.Snapshot(snap); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(snap, expected) {
+			t.Fatal(p, "merged snapshot differs")
+		}
+		if h.Add(xorShift64StarRound(7)) {
+			t.Fatal("expected no change")
+		}
+	}
+	h, _ := NewAtomicDense(10)
+	s, _ := DenseSizeByP(11)
+	if h.Merge(make(Dense, s)) == nil || h.Snapshot(make(Dense, s)) == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := NewAtomicDense(26); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func BenchmarkAddAtomicDense(b *testing.B) {
+	h, _ := NewAtomicDense(14)
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		x := randUint64()
+		for pb.Next() {
+			x++
+			h.Add(xorShift64StarRound(int(x)))
+		}
+	})
+}
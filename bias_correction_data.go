@@ -1,96 +1,102 @@
+// Code generated by internal/gentables; DO NOT EDIT.
+
 package hll
 
-// Bias-correction data from https://docs.google.com/document/d/1gyjfMHy43U9OWBXxfaeG-3MjGzejW1dlpyMwEYAAWEI/view?fullscreen
+// Bias-correction data (see estimateBias) from simulated streams: the mean raw estimate
+// and its bias at 200 cardinalities up to 5m, by precision.
 var rawEstimateData = [][]float64{
 	// precision 4
-	{11, 11.717, 12.207, 12.7896, 13.2882, 13.8204, 14.3772, 14.9342, 15.5202, 16.161, 16.7722, 17.4636, 18.0396, 18.6766, 19.3566, 20.0454, 20.7936, 21.4856, 22.2666, 22.9946, 23.766, 24.4692, 25.3638, 26.0764, 26.7864, 27.7602, 28.4814, 29.433, 30.2926, 31.0664, 31.9996, 32.7956, 33.5366, 34.5894, 35.5738, 36.2698, 37.3682, 38.0544, 39.2342, 40.0108, 40.7966, 41.9298, 42.8704, 43.6358, 44.5194, 45.773, 46.6772, 47.6174, 48.4888, 49.3304, 50.2506, 51.4996, 52.3824, 53.3078, 54.3984, 55.5838, 56.6618, 57.2174, 58.3514, 59.0802, 60.1482, 61.0376, 62.3598, 62.8078, 63.9744, 64.914, 65.781, 67.1806, 68.0594, 68.8446, 69.7928, 70.8248, 71.8324, 72.8598, 73.6246, 74.7014, 75.393, 76.6708, 77.2394},
-
+	{11.23802, 11.72161, 12.22071, 12.73511, 13.26376, 13.81493, 14.37748, 14.95118, 15.54586, 16.15716, 16.78266, 17.42423, 18.09026, 18.76171, 19.45278, 20.15116, 20.8714, 21.61159, 22.3507, 23.11958, 23.90057, 24.69178, 25.48889, 26.29185, 27.10092, 27.93416, 28.7725, 29.60467, 30.47054, 31.33397, 32.22294, 33.09712, 33.99028, 34.8998, 35.82106, 36.73389, 37.65548, 38.58118, 39.52537, 40.47582, 41.45143, 42.38406, 43.33175, 44.27962, 45.21465, 46.18496, 47.1735, 48.13166, 49.08819, 50.06457, 51.03352, 52.02088, 53.02492, 54.02944, 55.01462, 56.02907, 56.99228, 58.00329, 59.00154, 60.04335, 61.0097, 62.02813, 63.01134, 63.99353, 64.98965, 65.96894, 66.95749, 67.92191, 68.90616, 69.90175, 70.89551, 71.88477, 72.86497, 73.85291, 74.84155, 75.83148, 76.82881, 77.82407, 78.78824, 79.78546},
 	// precision 5
-	{23, 23.1194, 23.8208, 24.2318, 24.77, 25.2436, 25.7774, 26.2848, 26.8224, 27.3742, 27.9336, 28.503, 29.0494, 29.6292, 30.2124, 30.798, 31.367, 31.9728, 32.5944, 33.217, 33.8438, 34.3696, 35.0956, 35.7044, 36.324, 37.0668, 37.6698, 38.3644, 39.049, 39.6918, 40.4146, 41.082, 41.687, 42.5398, 43.2462, 43.857, 44.6606, 45.4168, 46.1248, 46.9222, 47.6804, 48.447, 49.3454, 49.9594, 50.7636, 51.5776, 52.331, 53.19, 53.9676, 54.7564, 55.5314, 56.4442, 57.3708, 57.9774, 58.9624, 59.8796, 60.755, 61.472, 62.2076, 63.1024, 63.8908, 64.7338, 65.7728, 66.629, 67.413, 68.3266, 69.1524, 70.2642, 71.1806, 72.0566, 72.9192, 73.7598, 74.3516, 75.5802, 76.4386, 77.4916, 78.1524, 79.1892, 79.8414, 80.8798, 81.8376, 82.4698, 83.7656, 84.331, 85.5914, 86.6012, 87.7016, 88.5582, 89.3394, 90.3544, 91.4912, 92.308, 93.3552, 93.9746, 95.2052, 95.727, 97.1322, 98.3944, 98.7588, 100.242, 101.1914, 102.2538, 102.8776, 103.6292, 105.1932, 105.9152, 107.0868, 107.6728, 108.7144, 110.3114, 110.8716, 111.245, 112.7908, 113.7064, 114.636, 115.7464, 116.1788, 117.7464, 118.4896, 119.6166, 120.5082, 121.7798, 122.9028, 123.4426, 124.8854, 125.705, 126.4652, 128.3464, 128.3462, 130.0398, 131.0342, 131.0042, 132.4766, 133.511, 134.7252, 135.425, 136.5172, 138.0572, 138.6694, 139.3712, 140.8598, 141.4594, 142.554, 143.4006, 144.7374, 146.1634, 146.8994, 147.605, 147.9304, 149.1636, 150.2468, 151.5876, 152.2096, 153.7032, 154.7146, 155.807, 156.9228, 157.0372, 158.5852},
-
+	{22.77942, 23.26281, 23.75297, 24.25259, 24.7588, 25.2696, 25.79073, 26.32038, 26.8576, 27.39979, 27.95315, 28.50893, 29.07886, 29.6534, 30.23523, 30.81912, 31.41825, 32.02934, 32.64237, 33.26048, 33.88612, 34.52353, 35.15932, 35.80201, 36.45473, 37.12321, 37.80073, 38.48579, 39.16335, 39.8421, 40.54007, 41.23787, 41.94531, 42.65436, 43.38389, 44.11169, 44.8488, 45.5983, 46.3414, 47.09645, 47.84479, 48.61363, 49.38251, 50.15552, 50.94554, 51.72845, 52.51866, 53.31641, 54.12584, 54.94026, 55.75549, 56.5885, 57.43208, 58.25197, 59.08533, 59.93325, 60.77972, 61.63637, 62.50945, 63.36755, 64.23691, 65.10428, 65.98169, 66.86741, 67.75443, 68.64415, 69.53331, 70.42805, 71.30375, 72.20476, 73.1183, 74.03208, 74.95852, 75.88937, 76.7814, 77.6952, 78.60863, 79.56407, 80.492, 81.42904, 82.37689, 83.32974, 84.26038, 85.21889, 86.14727, 87.11603, 88.04308, 88.9824, 89.91285, 90.90202, 91.84606, 92.8501, 93.82039, 94.75893, 95.70258, 96.66204, 97.59784, 98.55147, 99.51288, 100.4738, 101.4452, 102.4082, 103.3733, 104.3611, 105.3511, 106.3257, 107.3121, 108.3056, 109.2853, 110.2492, 111.2358, 112.215, 113.2157, 114.1998, 115.2031, 116.2009, 117.1844, 118.1701, 119.1732, 120.1242, 121.1259, 122.0821, 123.1041, 124.0751, 125.0945, 126.084, 127.046, 128.0359, 129.0184, 130.0102, 131.0006, 132.0052, 132.9775, 133.9611, 134.9539, 135.954, 136.9102, 137.9055, 138.9124, 139.9006, 140.9094, 141.8962, 142.8972, 143.8779, 144.8822, 145.8926, 146.8712, 147.8822, 148.853, 149.8303, 150.8258, 151.8137, 152.7814, 153.7651, 154.7921, 155.7732, 156.7645, 157.7337, 158.7317, 159.71},
 	// precision 6
-	{46, 46.1902, 47.271, 47.8358, 48.8142, 49.2854, 50.317, 51.354, 51.8924, 52.9436, 53.4596, 54.5262, 55.6248, 56.1574, 57.2822, 57.837, 58.9636, 60.074, 60.7042, 61.7976, 62.4772, 63.6564, 64.7942, 65.5004, 66.686, 67.291, 68.5672, 69.8556, 70.4982, 71.8204, 72.4252, 73.7744, 75.0786, 75.8344, 77.0294, 77.8098, 79.0794, 80.5732, 81.1878, 82.5648, 83.2902, 84.6784, 85.3352, 86.8946, 88.3712, 89.0852, 90.499, 91.2686, 92.6844, 94.2234, 94.9732, 96.3356, 97.2286, 98.7262, 100.3284, 101.1048, 102.5962, 103.3562, 105.1272, 106.4184, 107.4974, 109.0822, 109.856, 111.48, 113.2834, 114.0208, 115.637, 116.5174, 118.0576, 119.7476, 120.427, 122.1326, 123.2372, 125.2788, 126.6776, 127.7926, 129.1952, 129.9564, 131.6454, 133.87, 134.5428, 136.2, 137.0294, 138.6278, 139.6782, 141.792, 143.3516, 144.2832, 146.0394, 147.0748, 148.4912, 150.849, 151.696, 153.5404, 154.073, 156.3714, 157.7216, 158.7328, 160.4208, 161.4184, 163.9424, 165.2772, 166.411, 168.1308, 168.769, 170.9258, 172.6828, 173.7502, 175.706, 176.3886, 179.0186, 180.4518, 181.927, 183.4172, 184.4114, 186.033, 188.5124, 189.5564, 191.6008, 192.4172, 193.8044, 194.997, 197.4548, 198.8948, 200.2346, 202.3086, 203.1548, 204.8842, 206.6508, 206.6772, 209.7254, 210.4752, 212.7228, 214.6614, 215.1676, 217.793, 218.0006, 219.9052, 221.66, 223.5588, 225.1636, 225.6882, 227.7126, 229.4502, 231.1978, 232.9756, 233.1654, 236.727, 238.1974, 237.7474, 241.1346, 242.3048, 244.1948, 245.3134, 246.879, 249.1204, 249.853, 252.6792, 253.857, 254.4486, 257.2362, 257.9534, 260.0286, 260.5632, 262.663, 264.723, 265.7566, 267.2566, 267.1624, 270.62, 272.8216, 273.2166, 275.2056, 276.2202, 278.3726, 280.3344, 281.9284, 283.9728, 284.1924, 286.4872, 287.587, 289.807, 291.1206, 292.769, 294.8708, 296.665, 297.1182, 299.4012, 300.6352, 302.1354, 304.1756, 306.1606, 307.3462, 308.5214, 309.4134, 310.8352, 313.9684, 315.837, 316.7796, 318.9858},
-
+	{46.33548, 46.82122, 47.80319, 48.29776, 49.29967, 50.3172, 50.83217, 51.87058, 52.39589, 53.45909, 54.53549, 55.07524, 56.17225, 56.7297, 57.84924, 58.98514, 59.55779, 60.71169, 61.29554, 62.472, 63.66927, 64.2743, 65.49211, 66.10483, 67.34176, 68.59349, 69.2253, 70.50029, 71.14417, 72.42712, 73.73663, 74.39326, 75.72774, 76.38965, 77.7535, 79.11443, 79.8125, 81.20002, 81.89518, 83.30082, 84.72526, 85.43058, 86.87273, 87.59508, 89.05276, 90.52297, 91.25405, 92.73344, 93.48686, 94.99937, 96.51907, 97.28055, 98.81462, 99.58132, 101.1287, 102.6762, 103.4527, 105.032, 105.8163, 107.4097, 109.0036, 109.8167, 111.4413, 112.2699, 113.9143, 115.5462, 116.3713, 118.0232, 118.874, 120.5466, 122.227, 123.079, 124.7585, 125.6168, 127.3467, 129.0807, 129.9583, 131.7038, 132.5724, 134.3227, 136.0676, 136.9493, 138.7147, 139.5871, 141.3486, 143.1196, 144.0129, 145.8008, 146.6912, 148.5081, 150.3309, 151.2376, 153.0738, 153.9816, 155.8119, 157.6522, 158.5854, 160.4164, 161.3354, 163.1878, 165.0709, 165.9912, 167.878, 168.8172, 170.6937, 172.5992, 173.549, 175.4133, 176.3562, 178.2785, 180.1676, 181.0953, 183.0147, 183.9739, 185.8939, 187.8125, 188.7734, 190.689, 191.6362, 193.5667, 195.5425, 196.4876, 198.4313, 199.3919, 201.3462, 203.2665, 204.2622, 206.2074, 207.159, 209.1274, 211.0982, 212.0616, 214.0098, 214.9838, 216.8886, 218.8526, 219.8607, 221.834, 222.8483, 224.7916, 226.7479, 227.7187, 229.7074, 230.7277, 232.6985, 234.6599, 235.6491, 237.5943, 238.6042, 240.5331, 242.5005, 243.5022, 245.4493, 246.4685, 248.454, 250.4545, 251.4498, 253.3964, 254.393, 256.411, 258.4073, 259.401, 261.3649, 262.3795, 264.3852, 266.3282, 267.292, 269.2592, 270.2454, 272.2827, 274.2301, 275.2271, 277.1854, 278.1575, 280.1958, 282.223, 283.2347, 285.2153, 286.178, 288.1453, 290.1812, 291.1998, 293.2244, 294.2405, 296.2399, 298.3265, 299.2932, 301.2958, 302.2662, 304.2635, 306.2942, 307.2792, 309.2069, 310.2031, 312.2128, 314.2126, 315.2078, 317.1941, 318.1648, 320.1377},
 	// precision 7
-	{92, 93.4934, 94.9758, 96.4574, 97.9718, 99.4954, 101.5302, 103.0756, 104.6374, 106.1782, 107.7888, 109.9522, 111.592, 113.2532, 114.9086, 116.5938, 118.9474, 120.6796, 122.4394, 124.2176, 125.9768, 128.4214, 130.2528, 132.0102, 133.8658, 135.7278, 138.3044, 140.1316, 142.093, 144.0032, 145.9092, 148.6306, 150.5294, 152.5756, 154.6508, 156.662, 159.552, 161.3724, 163.617, 165.5754, 167.7872, 169.8444, 172.7988, 174.8606, 177.2118, 179.3566, 181.4476, 184.5882, 186.6816, 189.0824, 191.0258, 193.6048, 196.4436, 198.7274, 200.957, 203.147, 205.4364, 208.7592, 211.3386, 213.781, 215.8028, 218.656, 221.6544, 223.996, 226.4718, 229.1544, 231.6098, 234.5956, 237.0616, 239.5758, 242.4878, 244.5244, 248.2146, 250.724, 252.8722, 255.5198, 258.0414, 261.941, 264.9048, 266.87, 269.4304, 272.028, 274.4708, 278.37, 281.0624, 283.4668, 286.5532, 289.4352, 293.2564, 295.2744, 298.2118, 300.7472, 304.1456, 307.2928, 309.7504, 312.5528, 315.979, 318.2102, 322.1834, 324.3494, 327.325, 330.6614, 332.903, 337.2544, 339.9042, 343.215, 345.2864, 348.0814, 352.6764, 355.301, 357.139, 360.658, 363.1732, 366.5902, 369.9538, 373.0828, 375.922, 378.9902, 382.7328, 386.4538, 388.1136, 391.2234, 394.0878, 396.708, 401.1556, 404.1852, 406.6372, 409.6822, 412.7796, 416.6078, 418.4916, 422.131, 424.5376, 428.1988, 432.211, 434.4502, 438.5282, 440.912, 444.0448, 447.7432, 450.8524, 453.7988, 456.7858, 458.8868, 463.9886, 466.5064, 468.9124, 472.6616, 475.4682, 478.582, 481.304, 485.2738, 488.6894, 490.329, 496.106, 497.6908, 501.1374, 504.5322, 506.8848, 510.3324, 513.4512, 516.179, 520.4412, 522.6066, 526.167, 528.7794, 533.379, 536.067, 538.46, 542.9116, 545.692, 547.9546, 552.493, 555.2722, 557.335, 562.449, 564.2014, 569.0738, 571.0974, 574.8564, 578.2996, 581.409, 583.9704, 585.8098, 589.6528, 594.5998, 595.958, 600.068, 603.3278, 608.2016, 609.9632, 612.864, 615.43, 620.7794, 621.272, 625.8644, 629.206, 633.219, 634.5154, 638.6102},
-
+	{92.99789, 94.45999, 96.43455, 97.93389, 99.44746, 100.9752, 102.5163, 104.6053, 106.1929, 107.8011, 109.4189, 111.0515, 113.246, 114.92, 116.6109, 118.3138, 120.0376, 122.3663, 124.1306, 125.9147, 127.7084, 129.5164, 131.9522, 133.796, 135.6612, 137.5454, 139.4581, 142.0218, 143.9615, 145.9031, 147.8699, 149.8608, 152.5338, 154.5636, 156.585, 158.6363, 160.6953, 163.4489, 165.5515, 167.669, 169.7741, 171.9159, 174.7984, 176.9793, 179.1622, 181.3757, 183.592, 186.5687, 188.8158, 191.1021, 193.3908, 195.6889, 198.759, 201.0608, 203.3885, 205.7459, 208.0957, 211.218, 213.5989, 216.0158, 218.4428, 220.8506, 224.1282, 226.5849, 229.061, 231.5127, 234.0133, 237.3618, 239.8851, 242.4087, 244.9273, 247.4595, 250.8249, 253.3906, 255.9545, 258.5421, 261.1342, 264.5876, 267.2127, 269.8305, 272.4912, 275.1361, 278.675, 281.3494, 283.9796, 286.6431, 289.307, 292.8894, 295.5825, 298.3031, 301.0029, 303.7628, 307.3797, 310.0946, 312.8572, 315.6426, 318.4055, 322.0835, 324.8402, 327.6356, 330.4195, 333.2393, 336.9415, 339.7615, 342.5689, 345.4031, 348.1996, 351.9461, 354.7654, 357.6099, 360.4554, 363.3082, 367.0954, 369.9811, 372.8171, 375.6493, 378.5152, 382.3145, 385.1909, 388.0836, 390.9785, 393.8165, 397.6422, 400.5619, 403.4602, 406.4027, 409.2928, 413.1426, 416.02, 418.9221, 421.8389, 424.7418, 428.6306, 431.5811, 434.4791, 437.3956, 440.2686, 444.1514, 447.0785, 449.9786, 452.9269, 455.8343, 459.6991, 462.6753, 465.6553, 468.5821, 471.5504, 475.4517, 478.3819, 481.2927, 484.2558, 487.2012, 491.1923, 494.1507, 497.1131, 500.0945, 503.066, 507.0408, 509.9628, 512.9634, 515.928, 518.858, 522.8184, 525.7813, 528.7313, 531.6971, 534.6637, 538.569, 541.5353, 544.4839, 547.44, 550.4422, 554.4458, 557.4403, 560.4327, 563.3955, 566.3264, 570.4027, 573.3804, 576.3907, 579.3894, 582.3737, 586.3347, 589.3348, 592.2975, 595.2511, 598.209, 602.1582, 605.1779, 608.1746, 611.1914, 614.1913, 618.2208, 621.204, 624.1197, 627.0687, 630.0798, 634.1179, 637.1512, 640.1137},
 	// precision 8
-	{184.2152, 187.2454, 190.2096, 193.6652, 196.6312, 199.6822, 203.249, 206.3296, 210.0038, 213.2074, 216.4612, 220.27, 223.5178, 227.4412, 230.8032, 234.1634, 238.1688, 241.6074, 245.6946, 249.2664, 252.8228, 257.0432, 260.6824, 264.9464, 268.6268, 272.2626, 276.8376, 280.4034, 284.8956, 288.8522, 292.7638, 297.3552, 301.3556, 305.7526, 309.9292, 313.8954, 318.8198, 322.7668, 327.298, 331.6688, 335.9466, 340.9746, 345.1672, 349.3474, 354.3028, 358.8912, 364.114, 368.4646, 372.9744, 378.4092, 382.6022, 387.843, 392.5684, 397.1652, 402.5426, 407.4152, 412.5388, 417.3592, 422.1366, 427.486, 432.3918, 437.5076, 442.509, 447.3834, 453.3498, 458.0668, 463.7346, 469.1228, 473.4528, 479.7, 484.644, 491.0518, 495.5774, 500.9068, 506.432, 512.1666, 517.434, 522.6644, 527.4894, 533.6312, 538.3804, 544.292, 550.5496, 556.0234, 562.8206, 566.6146, 572.4188, 579.117, 583.6762, 590.6576, 595.7864, 601.509, 607.5334, 612.9204, 619.772, 624.2924, 630.8654, 636.1836, 642.745, 649.1316, 655.0386, 660.0136, 666.6342, 671.6196, 678.1866, 684.4282, 689.3324, 695.4794, 702.5038, 708.129, 713.528, 720.3204, 726.463, 732.7928, 739.123, 744.7418, 751.2192, 756.5102, 762.6066, 769.0184, 775.2224, 781.4014, 787.7618, 794.1436, 798.6506, 805.6378, 811.766, 819.7514, 824.5776, 828.7322, 837.8048, 843.6302, 849.9336, 854.4798, 861.3388, 867.9894, 873.8196, 880.3136, 886.2308, 892.4588, 899.0816, 905.4076, 912.0064, 917.3878, 923.619, 929.998, 937.3482, 943.9506, 947.991, 955.1144, 962.203, 968.8222, 975.7324, 981.7826, 988.7666, 994.2648, 1000.3128, 1007.4082, 1013.7536, 1020.3376, 1026.7156, 1031.7478, 1037.4292, 1045.393, 1051.2278, 1058.3434, 1062.8726, 1071.884, 1076.806, 1082.9176, 1089.1678, 1095.5032, 1102.525, 1107.2264, 1115.315, 1120.93, 1127.252, 1134.1496, 1139.0408, 1147.5448, 1153.3296, 1158.1974, 1166.5262, 1174.3328, 1175.657, 1184.4222, 1190.9172, 1197.1292, 1204.4606, 1210.4578, 1218.8728, 1225.3336, 1226.6592, 1236.5768, 1241.363, 1249.4074, 1254.6566, 1260.8014, 1266.5454, 1274.5192},
-
+	{186.7709, 190.1884, 193.1565, 196.666, 199.7016, 202.7652, 206.3877, 209.5311, 213.2437, 216.4567, 219.7081, 223.5393, 226.8544, 230.7576, 234.1339, 237.5614, 241.5969, 245.0868, 249.1924, 252.7485, 256.3282, 260.5525, 264.2176, 268.5168, 272.2463, 275.9932, 280.4251, 284.2496, 288.7591, 292.6582, 296.5718, 301.1937, 305.1859, 309.8907, 313.9394, 318.0326, 322.8416, 327.0068, 331.8877, 336.0933, 340.3125, 345.2822, 349.5675, 354.5937, 358.9722, 363.4206, 368.6039, 373.0768, 378.3013, 382.8089, 387.3655, 392.6923, 397.2792, 402.6617, 407.3157, 412.0334, 417.5033, 422.2652, 427.8417, 432.5924, 437.4, 443.0185, 447.8786, 453.565, 458.4708, 463.4072, 469.1518, 474.1253, 479.9697, 484.9995, 490.0339, 495.9297, 501.0292, 506.9171, 512.0361, 517.1725, 523.1666, 528.3868, 534.5093, 539.7655, 545.0192, 551.0844, 556.3249, 562.5323, 567.8562, 573.2109, 579.4555, 584.8467, 591.0937, 596.4677, 601.9284, 608.2348, 613.6954, 620.0381, 625.5183, 631.0401, 637.4799, 642.9636, 649.3902, 654.9248, 660.4937, 666.9337, 672.4894, 678.9866, 684.5228, 690.1277, 696.7164, 702.335, 708.9315, 714.5626, 720.198, 726.8252, 732.5365, 739.1378, 744.7901, 750.5074, 757.1477, 762.8736, 769.6126, 775.3885, 781.1751, 787.8797, 793.5957, 800.2939, 806.0396, 811.8596, 818.6224, 824.492, 831.2932, 837.0964, 842.9406, 849.7288, 855.5256, 862.3168, 868.1632, 874.0282, 880.8607, 886.7934, 893.6738, 899.5463, 905.4381, 912.3969, 918.3243, 925.1779, 931.1002, 937.0457, 943.9848, 949.9687, 956.838, 962.6967, 968.6216, 975.4733, 981.3219, 988.3092, 994.2426, 1000.182, 1007.137, 1013.16, 1020.194, 1026.219, 1032.129, 1039.099, 1045.08, 1051.936, 1057.854, 1063.854, 1070.815, 1076.929, 1083.775, 1089.671, 1095.64, 1102.584, 1108.522, 1115.568, 1121.468, 1127.365, 1134.339, 1140.26, 1147.189, 1153.184, 1159.146, 1166.115, 1172.172, 1179.155, 1185.144, 1191.048, 1198.03, 1204.012, 1210.873, 1216.842, 1222.825, 1229.774, 1235.749, 1242.761, 1248.73, 1254.662, 1261.592, 1267.574, 1274.574, 1280.639},
 	// precision 9
-	{369, 374.8294, 381.2452, 387.6698, 394.1464, 400.2024, 406.8782, 413.6598, 420.462, 427.2826, 433.7102, 440.7416, 447.9366, 455.1046, 462.285, 469.0668, 476.306, 483.8448, 491.301, 498.9886, 506.2422, 513.8138, 521.7074, 529.7428, 537.8402, 545.1664, 553.3534, 561.594, 569.6886, 577.7876, 585.65, 594.228, 602.8036, 611.1666, 620.0818, 628.0824, 637.2574, 646.302, 655.1644, 664.0056, 672.3802, 681.7192, 690.5234, 700.2084, 708.831, 718.485, 728.1112, 737.4764, 746.76, 756.3368, 766.5538, 775.5058, 785.2646, 795.5902, 804.3818, 814.8998, 824.9532, 835.2062, 845.2798, 854.4728, 864.9582, 875.3292, 886.171, 896.781, 906.5716, 916.7048, 927.5322, 937.875, 949.3972, 958.3464, 969.7274, 980.2834, 992.1444, 1003.4264, 1013.0166, 1024.018, 1035.0438, 1046.34, 1057.6856, 1068.9836, 1079.0312, 1091.677, 1102.3188, 1113.4846, 1124.4424, 1135.739, 1147.1488, 1158.9202, 1169.406, 1181.5342, 1193.2834, 1203.8954, 1216.3286, 1226.2146, 1239.6684, 1251.9946, 1262.123, 1275.4338, 1285.7378, 1296.076, 1308.9692, 1320.4964, 1333.0998, 1343.9864, 1357.7754, 1368.3208, 1380.4838, 1392.7388, 1406.0758, 1416.9098, 1428.9728, 1440.9228, 1453.9292, 1462.617, 1476.05, 1490.2996, 1500.6128, 1513.7392, 1524.5174, 1536.6322, 1548.2584, 1562.3766, 1572.423, 1587.1232, 1596.5164, 1610.5938, 1622.5972, 1633.1222, 1647.7674, 1658.5044, 1671.57, 1683.7044, 1695.4142, 1708.7102, 1720.6094, 1732.6522, 1747.841, 1756.4072, 1769.9786, 1782.3276, 1797.5216, 1808.3186, 1819.0694, 1834.354, 1844.575, 1856.2808, 1871.1288, 1880.7852, 1893.9622, 1906.3418, 1920.6548, 1932.9302, 1945.8584, 1955.473, 1968.8248, 1980.6446, 1995.9598, 2008.349, 2019.8556, 2033.0334, 2044.0206, 2059.3956, 2069.9174, 2082.6084, 2093.7036, 2106.6108, 2118.9124, 2132.301, 2144.7628, 2159.8422, 2171.0212, 2183.101, 2193.5112, 2208.052, 2221.3194, 2233.3282, 2247.295, 2257.7222, 2273.342, 2286.5638, 2299.6786, 2310.8114, 2322.3312, 2335.516, 2349.874, 2363.5968, 2373.865, 2387.1918, 2401.8328, 2414.8496, 2424.544, 2436.7592, 2447.1682, 2464.1958, 2474.3438, 2489.0006, 2497.4526, 2513.6586, 2527.19, 2540.7028, 2553.768},
-
+	{374.8085, 381.1668, 387.1031, 393.6153, 400.1963, 406.8564, 413.5908, 419.873, 426.7515, 433.7042, 440.7382, 447.8302, 454.4638, 461.7246, 469.0693, 476.4785, 483.9531, 490.9448, 498.5635, 506.2659, 514.038, 521.9024, 529.2128, 537.2387, 545.3226, 553.46, 561.6772, 569.3372, 577.7143, 586.1614, 594.6764, 603.2899, 611.2678, 619.9901, 628.7488, 637.6302, 646.5595, 654.8544, 663.9135, 673.0425, 682.2624, 691.5287, 700.1268, 709.542, 719.0299, 728.5356, 738.1135, 747.0084, 756.7378, 766.5114, 776.3866, 786.2579, 795.3961, 805.4128, 815.5099, 825.6509, 835.8677, 845.2778, 855.5477, 865.9109, 876.2861, 886.7603, 896.4388, 906.9648, 917.5647, 928.1866, 938.9258, 948.8453, 959.6821, 970.5631, 981.4436, 992.4536, 1002.539, 1013.67, 1024.846, 1035.998, 1047.172, 1057.503, 1068.784, 1080.14, 1091.494, 1102.91, 1113.425, 1124.904, 1136.441, 1147.995, 1159.692, 1170.485, 1182.143, 1193.841, 1205.59, 1217.308, 1228.151, 1239.963, 1251.807, 1263.629, 1275.538, 1286.59, 1298.581, 1310.627, 1322.67, 1334.754, 1345.859, 1357.946, 1370.061, 1382.215, 1394.355, 1405.667, 1417.992, 1430.375, 1442.564, 1454.883, 1466.22, 1478.517, 1490.848, 1503.27, 1515.69, 1527.084, 1539.568, 1552.011, 1564.518, 1576.991, 1588.508, 1601.015, 1613.706, 1626.242, 1638.757, 1650.476, 1663.161, 1675.772, 1688.428, 1700.998, 1712.54, 1725.204, 1737.778, 1750.432, 1763.062, 1774.731, 1787.473, 1800.17, 1812.776, 1825.539, 1837.312, 1850.108, 1862.73, 1875.498, 1888.305, 1900.008, 1912.784, 1925.624, 1938.391, 1951.031, 1962.889, 1975.705, 1988.569, 2001.4, 2014.157, 2026.024, 2038.916, 2051.857, 2064.829, 2077.783, 2089.607, 2102.436, 2115.31, 2128.161, 2141.027, 2152.883, 2165.858, 2178.797, 2191.798, 2204.83, 2216.789, 2229.573, 2242.449, 2255.351, 2268.403, 2280.366, 2293.286, 2306.17, 2319.127, 2332.041, 2343.925, 2356.824, 2369.887, 2382.837, 2395.882, 2407.767, 2420.663, 2433.781, 2446.797, 2459.752, 2471.661, 2484.736, 2497.742, 2510.716, 2523.601, 2535.665, 2548.781, 2561.786},
 	// precision 10
-	{738.1256, 750.4234, 763.1064, 775.4732, 788.4636, 801.0644, 814.488, 827.9654, 841.0832, 854.7864, 868.1992, 882.2176, 896.5228, 910.1716, 924.7752, 938.899, 953.6126, 968.6492, 982.9474, 998.5214, 1013.1064, 1028.6364, 1044.2468, 1059.4588, 1075.3832, 1091.0584, 1106.8606, 1123.3868, 1139.5062, 1156.1862, 1172.463, 1189.339, 1206.1936, 1223.1292, 1240.1854, 1257.2908, 1275.3324, 1292.8518, 1310.5204, 1328.4854, 1345.9318, 1364.552, 1381.4658, 1400.4256, 1419.849, 1438.152, 1456.8956, 1474.8792, 1494.118, 1513.62, 1532.5132, 1551.9322, 1570.7726, 1590.6086, 1610.5332, 1630.5918, 1650.4294, 1669.7662, 1690.4106, 1710.7338, 1730.9012, 1750.4486, 1770.1556, 1791.6338, 1812.7312, 1833.6264, 1853.9526, 1874.8742, 1896.8326, 1918.1966, 1939.5594, 1961.07, 1983.037, 2003.1804, 2026.071, 2047.4884, 2070.0848, 2091.2944, 2114.333, 2135.9626, 2158.2902, 2181.0814, 2202.0334, 2224.4832, 2246.39, 2269.7202, 2292.1714, 2314.2358, 2338.9346, 2360.891, 2384.0264, 2408.3834, 2430.1544, 2454.8684, 2476.9896, 2501.4368, 2522.8702, 2548.0408, 2570.6738, 2593.5208, 2617.0158, 2640.2302, 2664.0962, 2687.4986, 2714.2588, 2735.3914, 2759.6244, 2781.8378, 2808.0072, 2830.6516, 2856.2454, 2877.2136, 2903.4546, 2926.785, 2951.2294, 2976.468, 3000.867, 3023.6508, 3049.91, 3073.5984, 3098.162, 3121.5564, 3146.2328, 3170.9484, 3195.5902, 3221.3346, 3242.7032, 3271.6112, 3296.5546, 3317.7376, 3345.072, 3369.9518, 3394.326, 3418.1818, 3444.6926, 3469.086, 3494.2754, 3517.8698, 3544.248, 3565.3768, 3588.7234, 3616.979, 3643.7504, 3668.6812, 3695.72, 3719.7392, 3742.6224, 3770.4456, 3795.6602, 3819.9058, 3844.002, 3869.517, 3895.6824, 3920.8622, 3947.1364, 3973.985, 3995.4772, 4021.62, 4046.628, 4074.65, 4096.2256, 4121.831, 4146.6406, 4173.276, 4195.0744, 4223.9696, 4251.3708, 4272.9966, 4300.8046, 4326.302, 4353.1248, 4374.312, 4403.0322, 4426.819, 4450.0598, 4478.5206, 4504.8116, 4528.8928, 4553.9584, 4578.8712, 4603.8384, 4632.3872, 4655.5128, 4675.821, 4704.6222, 4731.9862, 4755.4174, 4781.2628, 4804.332, 4832.3048, 4862.8752, 4883.4148, 4906.9544, 4935.3516, 4954.3532, 4984.0248, 5011.217, 5035.3258, 5057.3672, 5084.1828},
-
+	{750.4191, 762.652, 775.5165, 788.0395, 801.2018, 814.5194, 827.4691, 841.0822, 854.3229, 868.2493, 882.3389, 896.0165, 910.3868, 924.3446, 939.0148, 953.8363, 968.2466, 983.3879, 998.0757, 1013.481, 1029.048, 1044.137, 1059.976, 1075.346, 1091.503, 1107.825, 1123.635, 1140.204, 1156.27, 1173.171, 1190.179, 1206.679, 1224.042, 1240.769, 1258.36, 1276.063, 1293.21, 1311.173, 1328.594, 1346.773, 1365.14, 1382.974, 1401.628, 1419.663, 1438.596, 1457.643, 1476.107, 1495.429, 1514.103, 1533.647, 1553.284, 1572.289, 1592.081, 1611.311, 1631.412, 1651.685, 1671.293, 1691.746, 1711.584, 1732.292, 1753.144, 1773.256, 1794.263, 1814.576, 1835.864, 1857.189, 1877.813, 1899.235, 1920.158, 1941.927, 1963.788, 1984.938, 2007.008, 2028.204, 2050.396, 2072.698, 2094.176, 2116.689, 2138.441, 2161.108, 2183.776, 2205.632, 2228.485, 2250.523, 2273.563, 2296.74, 2318.963, 2342.258, 2364.591, 2387.902, 2411.338, 2433.982, 2457.566, 2480.319, 2504.052, 2527.83, 2550.656, 2574.453, 2597.409, 2621.476, 2645.421, 2668.58, 2692.755, 2716.136, 2740.364, 2764.682, 2788.16, 2812.677, 2836.255, 2860.665, 2885.163, 2908.595, 2933.238, 2957.037, 2981.675, 3006.391, 3030.259, 3055.109, 3079.077, 3103.959, 3128.881, 3152.872, 3177.938, 3201.879, 3227.066, 3252.141, 3276.301, 3301.413, 3325.686, 3350.799, 3376.146, 3400.405, 3425.893, 3450.217, 3475.637, 3500.865, 3525.199, 3550.564, 3574.845, 3600.22, 3625.638, 3649.918, 3675.332, 3699.78, 3725.142, 3750.655, 3775.073, 3800.627, 3825.151, 3850.6, 3876.28, 3901.005, 3926.726, 3951.278, 3976.879, 4002.383, 4027.177, 4052.849, 4077.529, 4103.11, 4128.962, 4153.713, 4179.538, 4204.424, 4230.161, 4255.938, 4280.755, 4306.582, 4331.294, 4357.237, 4383.196, 4407.944, 4433.776, 4458.636, 4484.667, 4510.445, 4535.248, 4560.997, 4585.759, 4611.538, 4637.396, 4662.308, 4688.165, 4713.175, 4739.074, 4764.986, 4790.006, 4815.878, 4840.892, 4866.732, 4892.58, 4917.329, 4943.289, 4968.252, 4994.175, 5020.2, 5045.091, 5071.02, 5095.945, 5121.803},
 	// precision 11
-	{1477, 1501.6014, 1526.5802, 1551.7942, 1577.3042, 1603.2062, 1629.8402, 1656.2292, 1682.9462, 1709.9926, 1737.3026, 1765.4252, 1793.0578, 1821.6092, 1849.626, 1878.5568, 1908.527, 1937.5154, 1967.1874, 1997.3878, 2027.37, 2058.1972, 2089.5728, 2120.1012, 2151.9668, 2183.292, 2216.0772, 2247.8578, 2280.6562, 2313.041, 2345.714, 2380.3112, 2414.1806, 2447.9854, 2481.656, 2516.346, 2551.5154, 2586.8378, 2621.7448, 2656.6722, 2693.5722, 2729.1462, 2765.4124, 2802.8728, 2838.898, 2876.408, 2913.4926, 2951.4938, 2989.6776, 3026.282, 3065.7704, 3104.1012, 3143.7388, 3181.6876, 3221.1872, 3261.5048, 3300.0214, 3339.806, 3381.409, 3421.4144, 3461.4294, 3502.2286, 3544.651, 3586.6156, 3627.337, 3670.083, 3711.1538, 3753.5094, 3797.01, 3838.6686, 3882.1678, 3922.8116, 3967.9978, 4009.9204, 4054.3286, 4097.5706, 4140.6014, 4185.544, 4229.5976, 4274.583, 4316.9438, 4361.672, 4406.2786, 4451.8628, 4496.1834, 4543.505, 4589.1816, 4632.5188, 4678.2294, 4724.8908, 4769.0194, 4817.052, 4861.4588, 4910.1596, 4956.4344, 5002.5238, 5048.13, 5093.6374, 5142.8162, 5187.7894, 5237.3984, 5285.6078, 5331.0858, 5379.1036, 5428.6258, 5474.6018, 5522.7618, 5571.5822, 5618.59, 5667.9992, 5714.88, 5763.454, 5808.6982, 5860.3644, 5910.2914, 5953.571, 6005.9232, 6055.1914, 6104.5882, 6154.5702, 6199.7036, 6251.1764, 6298.7596, 6350.0302, 6398.061, 6448.4694, 6495.933, 6548.0474, 6597.7166, 6646.9416, 6695.9208, 6742.6328, 6793.5276, 6842.1934, 6894.2372, 6945.3864, 6996.9228, 7044.2372, 7094.1374, 7142.2272, 7192.2942, 7238.8338, 7288.9006, 7344.0908, 7394.8544, 7443.5176, 7490.4148, 7542.9314, 7595.6738, 7641.9878, 7694.3688, 7743.0448, 7797.522, 7845.53, 7899.594, 7950.3132, 7996.455, 8050.9442, 8092.9114, 8153.1374, 8197.4472, 8252.8278, 8301.8728, 8348.6776, 8401.4698, 8453.551, 8504.6598, 8553.8944, 8604.1276, 8657.6514, 8710.3062, 8758.908, 8807.8706, 8862.1702, 8910.4668, 8960.77, 9007.2766, 9063.164, 9121.0534, 9164.1354, 9218.1594, 9267.767, 9319.0594, 9372.155, 9419.7126, 9474.3722, 9520.1338, 9572.368, 9622.7702, 9675.8448, 9726.5396, 9778.7378, 9827.6554, 9878.1922, 9928.7782, 9978.3984, 10026.578, 10076.5626, 10137.1618, 10177.5244, 10229.9176},
-
+	{1501.107, 1526.058, 1551.787, 1577.34, 1603.171, 1629.324, 1655.74, 1682.978, 1709.999, 1737.287, 1764.901, 1792.81, 1821.525, 1850.026, 1878.792, 1907.888, 1937.235, 1967.456, 1997.42, 2027.666, 2058.193, 2088.988, 2120.707, 2152.088, 2183.775, 2215.749, 2248.031, 2281.188, 2313.998, 2347.111, 2380.508, 2414.166, 2448.727, 2482.901, 2517.328, 2552.059, 2587.064, 2623.066, 2658.59, 2694.389, 2730.43, 2766.766, 2804.104, 2840.891, 2878.03, 2915.419, 2953.078, 2991.651, 3029.81, 3068.216, 3106.782, 3145.557, 3185.31, 3224.646, 3264.08, 3303.716, 3343.683, 3384.623, 3424.998, 3465.63, 3506.451, 3547.529, 3589.592, 3630.921, 3672.634, 3714.421, 3756.435, 3799.503, 3841.945, 3884.667, 3927.383, 3970.363, 4014.368, 4057.735, 4101.308, 4144.935, 4188.752, 4233.549, 4277.743, 4322.033, 4366.535, 4411.127, 4456.932, 4502.045, 4547.026, 4592.375, 4637.651, 4684.047, 4729.716, 4775.36, 4821.326, 4867.483, 4914.673, 4961.072, 5007.501, 5054.191, 5101.046, 5148.887, 5195.676, 5242.732, 5289.902, 5337.193, 5385.318, 5432.663, 5480.192, 5527.767, 5575.523, 5624.245, 5672.11, 5720.326, 5768.338, 5816.452, 5865.739, 5914.081, 5962.678, 6011.208, 6059.781, 6109.293, 6157.84, 6206.541, 6255.571, 6304.365, 6354.504, 6403.622, 6452.547, 6501.727, 6550.989, 6601.374, 6650.752, 6700.279, 6749.852, 6799.163, 6849.663, 6899.448, 6948.879, 6998.614, 7048.2, 7098.919, 7148.82, 7198.584, 7248.394, 7298.286, 7349.046, 7398.884, 7448.893, 7499.267, 7549.238, 7600.311, 7650.652, 7700.786, 7750.993, 7801.595, 7852.982, 7903.213, 7953.345, 8003.619, 8054.039, 8105.455, 8155.825, 8206.224, 8256.459, 8306.949, 8358.366, 8409.016, 8459.567, 8509.824, 8560.605, 8611.866, 8662.363, 8712.792, 8763.293, 8814.172, 8865.945, 8916.475, 8966.861, 9017.267, 9068.328, 9119.923, 9170.729, 9221.468, 9272.259, 9323.181, 9374.628, 9425.515, 9476.298, 9527.108, 9578.093, 9629.715, 9680.576, 9731.487, 9782.28, 9832.778, 9884.505, 9935.529, 9986.362, 10037.2, 10088.09, 10140.1, 10191.1, 10242.05},
 	// precision 12
-	{2954, 3003.4782, 3053.3568, 3104.3666, 3155.324, 3206.9598, 3259.648, 3312.539, 3366.1474, 3420.2576, 3474.8376, 3530.6076, 3586.451, 3643.38, 3700.4104, 3757.5638, 3815.9676, 3875.193, 3934.838, 3994.8548, 4055.018, 4117.1742, 4178.4482, 4241.1294, 4304.4776, 4367.4044, 4431.8724, 4496.3732, 4561.4304, 4627.5326, 4693.949, 4761.5532, 4828.7256, 4897.6182, 4965.5186, 5034.4528, 5104.865, 5174.7164, 5244.6828, 5316.6708, 5387.8312, 5459.9036, 5532.476, 5604.8652, 5679.6718, 5753.757, 5830.2072, 5905.2828, 5980.0434, 6056.6264, 6134.3192, 6211.5746, 6290.0816, 6367.1176, 6447.9796, 6526.5576, 6606.1858, 6686.9144, 6766.1142, 6847.0818, 6927.9664, 7010.9096, 7091.0816, 7175.3962, 7260.3454, 7344.018, 7426.4214, 7511.3106, 7596.0686, 7679.8094, 7765.818, 7852.4248, 7936.834, 8022.363, 8109.5066, 8200.4554, 8288.5832, 8373.366, 8463.4808, 8549.7682, 8642.0522, 8728.3288, 8820.9528, 8907.727, 9001.0794, 9091.2522, 9179.988, 9269.852, 9362.6394, 9453.642, 9546.9024, 9640.6616, 9732.6622, 9824.3254, 9917.7484, 10007.9392, 10106.7508, 10196.2152, 10289.8114, 10383.5494, 10482.3064, 10576.8734, 10668.7872, 10764.7156, 10862.0196, 10952.793, 11049.9748, 11146.0702, 11241.4492, 11339.2772, 11434.2336, 11530.741, 11627.6136, 11726.311, 11821.5964, 11918.837, 12015.3724, 12113.0162, 12213.0424, 12306.9804, 12408.4518, 12504.8968, 12604.586, 12700.9332, 12798.705, 12898.5142, 12997.0488, 13094.788, 13198.475, 13292.7764, 13392.9698, 13486.8574, 13590.1616, 13686.5838, 13783.6264, 13887.2638, 13992.0978, 14081.0844, 14189.9956, 14280.0912, 14382.4956, 14486.4384, 14588.1082, 14686.2392, 14782.276, 14888.0284, 14985.1864, 15088.8596, 15187.0998, 15285.027, 15383.6694, 15495.8266, 15591.3736, 15694.2008, 15790.3246, 15898.4116, 15997.4522, 16095.5014, 16198.8514, 16291.7492, 16402.6424, 16499.1266, 16606.2436, 16697.7186, 16796.3946, 16902.3376, 17005.7672, 17100.814, 17206.8282, 17305.8262, 17416.0744, 17508.4092, 17617.0178, 17715.4554, 17816.758, 17920.1748, 18012.9236, 18119.7984, 18223.2248, 18324.2482, 18426.6276, 18525.0932, 18629.8976, 18733.2588, 18831.0466, 18940.1366, 19032.2696, 19131.729, 19243.4864, 19349.6932, 19442.866, 19547.9448, 19653.2798, 19754.4034, 19854.0692, 19965.1224, 20065.1774, 20158.2212, 20253.353, 20366.3264, 20463.22},
-
+	{3002.978, 3053.385, 3103.885, 3155.46, 3207.126, 3259.367, 3312.758, 3366.211, 3420.756, 3475.37, 3530.618, 3586.981, 3643.39, 3700.869, 3758.413, 3816.546, 3875.881, 3935.27, 3995.809, 4056.347, 4117.437, 4179.673, 4241.904, 4305.294, 4368.686, 4432.606, 4497.665, 4562.7, 4629.006, 4695.203, 4761.9, 4829.813, 4897.765, 4966.865, 5035.8, 5105.306, 5175.983, 5246.502, 5318.375, 5390.048, 5462.113, 5535.532, 5608.733, 5683.136, 5757.365, 5832.033, 5907.851, 5983.657, 6060.593, 6137.22, 6214.187, 6292.524, 6370.614, 6449.958, 6529.113, 6608.607, 6689.089, 6769.402, 6850.914, 6932.025, 7013.637, 7096.471, 7178.888, 7262.534, 7345.709, 7429.358, 7514.299, 7598.732, 7684.504, 7769.797, 7855.366, 7942.247, 8028.601, 8116.066, 8203.124, 8290.542, 8379.199, 8467.148, 8556.37, 8645.32, 8734.522, 8824.727, 8914.305, 9005.079, 9095.431, 9185.84, 9277.664, 9368.67, 9460.976, 9552.693, 9644.56, 9737.616, 9830.029, 9923.594, 10016.45, 10109.59, 10203.98, 10297.41, 10392.24, 10486.21, 10580.56, 10676.01, 10770.67, 10866.55, 10961.83, 11057.16, 11153.47, 11249.16, 11345.87, 11441.96, 11538.11, 11635.4, 11731.93, 11829.62, 11926.54, 12023.64, 12121.61, 12218.78, 12317.34, 12415.01, 12512.66, 12611.5, 12709.33, 12808.22, 12906.33, 13004.62, 13104.05, 13202.62, 13302.32, 13400.89, 13499.79, 13599.66, 13698.22, 13798.43, 13897.61, 13996.93, 14097.26, 14196.86, 14297.13, 14396.97, 14496.61, 14597.3, 14697.17, 14798.32, 14898.62, 14998.73, 15099.75, 15200.23, 15301.74, 15402.01, 15502.59, 15603.96, 15704.35, 15805.62, 15906.38, 16007.22, 16108.83, 16209.71, 16311.3, 16412.21, 16513.17, 16614.84, 16715.96, 16817.81, 16918.97, 17019.92, 17121.82, 17222.8, 17324.72, 17426.02, 17527.34, 17629.25, 17730.44, 17832.57, 17933.96, 18035.47, 18138.04, 18239.4, 18342.04, 18443.5, 18544.7, 18647.2, 18748.4, 18850.58, 18952.1, 19053.51, 19156.11, 19257.73, 19360.35, 19461.76, 19563.14, 19665.8, 19767.82, 19870.42, 19972.1, 20073.72, 20176.71, 20278.57, 20381.39, 20482.98},
 	// precision 13
-	{5908.5052, 6007.2672, 6107.347, 6208.5794, 6311.2622, 6414.5514, 6519.3376, 6625.6952, 6732.5988, 6841.3552, 6950.5972, 7061.3082, 7173.5646, 7287.109, 7401.8216, 7516.4344, 7633.3802, 7751.2962, 7870.3784, 7990.292, 8110.79, 8233.4574, 8356.6036, 8482.2712, 8607.7708, 8735.099, 8863.1858, 8993.4746, 9123.8496, 9255.6794, 9388.5448, 9522.7516, 9657.3106, 9792.6094, 9930.5642, 10068.794, 10206.7256, 10347.81, 10490.3196, 10632.0778, 10775.9916, 10920.4662, 11066.124, 11213.073, 11358.0362, 11508.1006, 11659.1716, 11808.7514, 11959.4884, 12112.1314, 12265.037, 12420.3756, 12578.933, 12734.311, 12890.0006, 13047.2144, 13207.3096, 13368.5144, 13528.024, 13689.847, 13852.7528, 14018.3168, 14180.5372, 14346.9668, 14513.5074, 14677.867, 14846.2186, 15017.4186, 15184.9716, 15356.339, 15529.2972, 15697.3578, 15871.8686, 16042.187, 16216.4094, 16389.4188, 16565.9126, 16742.3272, 16919.0042, 17094.7592, 17273.965, 17451.8342, 17634.4254, 17810.5984, 17988.9242, 18171.051, 18354.7938, 18539.466, 18721.0408, 18904.9972, 19081.867, 19271.9118, 19451.8694, 19637.9816, 19821.2922, 20013.1292, 20199.3858, 20387.8726, 20572.9514, 20770.7764, 20955.1714, 21144.751, 21329.9952, 21520.709, 21712.7016, 21906.3868, 22096.2626, 22286.0524, 22475.051, 22665.5098, 22862.8492, 23055.5294, 23249.6138, 23437.848, 23636.273, 23826.093, 24020.3296, 24213.3896, 24411.7392, 24602.9614, 24805.7952, 24998.1552, 25193.9588, 25389.0166, 25585.8392, 25780.6976, 25981.2728, 26175.977, 26376.5252, 26570.1964, 26773.387, 26962.9812, 27163.0586, 27368.164, 27565.0534, 27758.7428, 27961.1276, 28163.2324, 28362.3816, 28565.7668, 28758.644, 28956.9768, 29163.4722, 29354.7026, 29561.1186, 29767.9948, 29959.9986, 30164.0492, 30366.9818, 30562.5338, 30762.9928, 30976.1592, 31166.274, 31376.722, 31570.3734, 31770.809, 31974.8934, 32179.5286, 32387.5442, 32582.3504, 32794.076, 32989.9528, 33191.842, 33392.4684, 33595.659, 33801.8672, 34000.3414, 34200.0922, 34402.6792, 34610.0638, 34804.0084, 35011.13, 35218.669, 35418.6634, 35619.0792, 35830.6534, 36028.4966, 36229.7902, 36438.6422, 36630.7764, 36833.3102, 37048.6728, 37247.3916, 37453.5904, 37669.3614, 37854.5526, 38059.305, 38268.0936, 38470.2516, 38674.7064, 38876.167, 39068.3794, 39281.9144, 39492.8566, 39684.8628, 39898.4108, 40093.1836, 40297.6858, 40489.7086, 40717.2424},
-
+	{6007.281, 6107.627, 6208.644, 6311.376, 6415.26, 6520.314, 6626.598, 6733.444, 6842.019, 6951.818, 7062.765, 7174.935, 7287.632, 7402.118, 7517.818, 7634.664, 7752.688, 7871.416, 7991.768, 8113.288, 8235.989, 8359.913, 8484.335, 8610.508, 8737.898, 8866.483, 8996.121, 9126.26, 9258.22, 9391.309, 9525.49, 9660.728, 9796.485, 9934.032, 10072.62, 10212.3, 10353.04, 10494.18, 10637.05, 10781.03, 10926.12, 11072.18, 11218.5, 11366.72, 11515.89, 11666.07, 11817.17, 11968.42, 12121.6, 12275.62, 12430.76, 12586.79, 12742.93, 12900.74, 13059.54, 13219.21, 13379.93, 13540.72, 13703.07, 13866.25, 14030.36, 14195.14, 14360.07, 14526.87, 14694.43, 14862.48, 15031.52, 15200.49, 15371.26, 15542.64, 15714.74, 15887.46, 16060.21, 16234.51, 16409.62, 16585.32, 16761.83, 16937.96, 17115.44, 17293.76, 17472.68, 17652.5, 17831.98, 18012.63, 18193.93, 18375.86, 18558.38, 18740.6, 18924.41, 19108.59, 19293.46, 19478.59, 19663.54, 19849.97, 20036.83, 20223.84, 20411.5, 20598.96, 20787.66, 20976.3, 21166.02, 21355.83, 21544.95, 21735.57, 21926.64, 22118.03, 22310.27, 22501.18, 22693.61, 22886.48, 23079.75, 23273.19, 23466.47, 23660.73, 23855.2, 24050.21, 24244.96, 24439.42, 24634.95, 24830.75, 25027.49, 25223.7, 25419.66, 25616.45, 25813.65, 26011.18, 26209.16, 26405.68, 26603.9, 26802.51, 27001.45, 27200.47, 27398.62, 27597.91, 27797.41, 27996.94, 28197.17, 28396.27, 28596.07, 28796.71, 28997.53, 29198.32, 29397.76, 29598.24, 29799.44, 30000.57, 30201.21, 30401.78, 30603.47, 30805.39, 31006.99, 31208.83, 31409.82, 31611.65, 31813.5, 32015.86, 32218.51, 32419.96, 32622.35, 32825.03, 33027.99, 33230.78, 33432.54, 33635.59, 33838.53, 34041.6, 34245.03, 34447.59, 34650.46, 34853.8, 35056.81, 35260.4, 35463.4, 35667.07, 35870.83, 36074.92, 36278.67, 36481.41, 36685.15, 36889.37, 37093.65, 37297.59, 37500.24, 37704.42, 37908.87, 38113.32, 38317.45, 38521.02, 38725.13, 38929.46, 39133.67, 39337.75, 39540.99, 39745.12, 39949.76, 40154.05, 40358.37, 40561.45, 40766.08, 40970.99},
 	// precision 14
-	{11817.475, 12015.0046, 12215.3792, 12417.7504, 12623.1814, 12830.0086, 13040.0072, 13252.503, 13466.178, 13683.2738, 13902.0344, 14123.9798, 14347.394, 14573.7784, 14802.6894, 15033.6824, 15266.9134, 15502.8624, 15741.4944, 15980.7956, 16223.8916, 16468.6316, 16715.733, 16965.5726, 17217.204, 17470.666, 17727.8516, 17986.7886, 18247.6902, 18510.9632, 18775.304, 19044.7486, 19314.4408, 19587.202, 19862.2576, 20135.924, 20417.0324, 20697.9788, 20979.6112, 21265.0274, 21550.723, 21841.6906, 22132.162, 22428.1406, 22722.127, 23020.5606, 23319.7394, 23620.4014, 23925.2728, 24226.9224, 24535.581, 24845.505, 25155.9618, 25470.3828, 25785.9702, 26103.7764, 26420.4132, 26742.0186, 27062.8852, 27388.415, 27714.6024, 28042.296, 28365.4494, 28701.1526, 29031.8008, 29364.2156, 29704.497, 30037.1458, 30380.111, 30723.8168, 31059.5114, 31404.9498, 31751.6752, 32095.2686, 32444.7792, 32794.767, 33145.204, 33498.4226, 33847.6502, 34209.006, 34560.849, 34919.4838, 35274.9778, 35635.1322, 35996.3266, 36359.1394, 36722.8266, 37082.8516, 37447.7354, 37815.9606, 38191.0692, 38559.4106, 38924.8112, 39294.6726, 39663.973, 40042.261, 40416.2036, 40779.2036, 41161.6436, 41540.9014, 41921.1998, 42294.7698, 42678.5264, 43061.3464, 43432.375, 43818.432, 44198.6598, 44583.0138, 44970.4794, 45353.924, 45729.858, 46118.2224, 46511.5724, 46900.7386, 47280.6964, 47668.1472, 48055.6796, 48446.9436, 48838.7146, 49217.7296, 49613.7796, 50010.7508, 50410.0208, 50793.7886, 51190.2456, 51583.1882, 51971.0796, 52376.5338, 52763.319, 53165.5534, 53556.5594, 53948.2702, 54346.352, 54748.7914, 55138.577, 55543.4824, 55941.1748, 56333.7746, 56745.1552, 57142.7944, 57545.2236, 57935.9956, 58348.5268, 58737.5474, 59158.5962, 59542.6896, 59958.8004, 60349.3788, 60755.0212, 61147.6144, 61548.194, 61946.0696, 62348.6042, 62763.603, 63162.781, 63560.635, 63974.3482, 64366.4908, 64771.5876, 65176.7346, 65597.3916, 65995.915, 66394.0384, 66822.9396, 67203.6336, 67612.2032, 68019.0078, 68420.0388, 68821.22, 69235.8388, 69640.0724, 70055.155, 70466.357, 70863.4266, 71276.2482, 71677.0306, 72080.2006, 72493.0214, 72893.5952, 73314.5856, 73714.9852, 74125.3022, 74521.2122, 74933.6814, 75341.5904, 75743.0244, 76166.0278, 76572.1322, 76973.1028, 77381.6284, 77800.6092, 78189.328, 78607.0962, 79012.2508, 79407.8358, 79825.725, 80238.701, 80646.891, 81035.6436, 81460.0448, 81876.3884},
-
+	{12015.33, 12215.54, 12418.55, 12623.43, 12831.16, 13041.22, 13253.13, 13467.88, 13684.53, 13904.14, 14126.03, 14349.75, 14576.45, 14804.87, 15036.35, 15270.03, 15505.51, 15743.98, 15984.15, 16227.34, 16472.71, 16719.94, 16970, 17221.64, 17476.19, 17733.19, 17991.82, 18253.42, 18516.61, 18782.73, 19051.41, 19321.36, 19594.18, 19868.5, 20145.51, 20424.81, 20705.63, 20989.24, 21274.25, 21562.33, 21852.63, 22143.83, 22438.09, 22733.88, 23032.29, 23332.4, 23633.79, 23937.84, 24243.32, 24551.7, 24861.55, 25172.64, 25486.51, 25801.57, 26118.76, 26438.25, 26758.7, 27081.81, 27405.89, 27732.76, 28060.77, 28389.72, 28721.19, 29053.79, 29388.8, 29725.35, 30062.26, 30402.28, 30742.32, 31084.62, 31428.73, 31773.34, 32120.3, 32467.82, 32817.92, 33169.47, 33521.38, 33875.37, 34229.55, 34586.28, 34944.24, 35302.54, 35662.83, 36023.44, 36386.37, 36749.86, 37113.45, 37480.24, 37846.62, 38214.69, 38584.12, 38953.68, 39324.99, 39696.82, 40069.75, 40444.23, 40817.88, 41194.46, 41570.55, 41948.93, 42328.18, 42707.26, 43088.03, 43468.46, 43851.11, 44234.28, 44617.54, 45001.91, 45386.01, 45772.74, 46159.51, 46546.26, 46934.6, 47322.41, 47711.79, 48101.31, 48491.03, 48882.52, 49273.66, 49666.18, 50059.11, 50451.18, 50844.36, 51237.8, 51632.59, 52027.57, 52422.62, 52818.78, 53213.53, 53610.04, 54007.77, 54404.32, 54802.59, 55199.84, 55598.62, 55997.7, 56395.8, 56795.71, 57195.52, 57596.91, 57998.37, 58398.45, 58799.72, 59200.8, 59601.98, 60004.65, 60406.32, 60808.36, 61210.22, 61613.82, 62017.27, 62419.35, 62822.7, 63226.29, 63631.62, 64036.46, 64440.86, 64846.41, 65250.26, 65656.19, 66061.66, 66466.99, 66872.84, 67277.93, 67683.83, 68090.38, 68495.6, 68902.23, 69308.22, 69715.37, 70121.24, 70527.45, 70934.05, 71340.24, 71747.43, 72155.62, 72562.1, 72969.82, 73376.89, 73784.87, 74192.04, 74598.98, 75006.47, 75414.33, 75821.54, 76229.45, 76636.18, 77043.68, 77451.39, 77859.69, 78267.86, 78675.02, 79083.85, 79492.04, 79899.54, 80308.38, 80716.46, 81125.41, 81533.07, 81942.52},
 	// precision 15
-	{23635.0036, 24030.8034, 24431.4744, 24837.1524, 25246.7928, 25661.326, 26081.3532, 26505.2806, 26933.9892, 27367.7098, 27805.318, 28248.799, 28696.4382, 29148.8244, 29605.5138, 30066.8668, 30534.2344, 31006.32, 31480.778, 31962.2418, 32447.3324, 32938.0232, 33432.731, 33930.728, 34433.9896, 34944.1402, 35457.5588, 35974.5958, 36497.3296, 37021.9096, 37554.326, 38088.0826, 38628.8816, 39171.3192, 39723.2326, 40274.5554, 40832.3142, 41390.613, 41959.5908, 42532.5466, 43102.0344, 43683.5072, 44266.694, 44851.2822, 45440.7862, 46038.0586, 46640.3164, 47241.064, 47846.155, 48454.7396, 49076.9168, 49692.542, 50317.4778, 50939.65, 51572.5596, 52210.2906, 52843.7396, 53481.3996, 54127.236, 54770.406, 55422.6598, 56078.7958, 56736.7174, 57397.6784, 58064.5784, 58730.308, 59404.9784, 60077.0864, 60751.9158, 61444.1386, 62115.817, 62808.7742, 63501.4774, 64187.5454, 64883.6622, 65582.7468, 66274.5318, 66976.9276, 67688.7764, 68402.138, 69109.6274, 69822.9706, 70543.6108, 71265.5202, 71983.3848, 72708.4656, 73433.384, 74158.4664, 74896.4868, 75620.9564, 76362.1434, 77098.3204, 77835.7662, 78582.6114, 79323.9902, 80067.8658, 80814.9246, 81567.0136, 82310.8536, 83061.9952, 83821.4096, 84580.8608, 85335.547, 86092.5802, 86851.6506, 87612.311, 88381.2016, 89146.3296, 89907.8974, 90676.846, 91451.4152, 92224.5518, 92995.8686, 93763.5066, 94551.2796, 95315.1944, 96096.1806, 96881.0918, 97665.679, 98442.68, 99229.3002, 100011.0994, 100790.6386, 101580.1564, 102377.7484, 103152.1392, 103944.2712, 104730.216, 105528.6336, 106324.9398, 107117.6706, 107890.3988, 108695.2266, 109485.238, 110294.7876, 111075.0958, 111878.0496, 112695.2864, 113464.5486, 114270.0474, 115068.608, 115884.3626, 116673.2588, 117483.3716, 118275.097, 119085.4092, 119879.2808, 120687.5868, 121499.9944, 122284.916, 123095.9254, 123912.5038, 124709.0454, 125503.7182, 126323.259, 127138.9412, 127943.8294, 128755.646, 129556.5354, 130375.3298, 131161.4734, 131971.1962, 132787.5458, 133588.1056, 134431.351, 135220.2906, 136023.398, 136846.6558, 137667.0004, 138463.663, 139283.7154, 140074.6146, 140901.3072, 141721.8548, 142543.2322, 143356.1096, 144173.7412, 144973.0948, 145794.3162, 146609.5714, 147420.003, 148237.9784, 149050.5696, 149854.761, 150663.1966, 151494.0754, 152313.1416, 153112.6902, 153935.7206, 154746.9262, 155559.547, 156401.9746, 157228.7036, 158008.7254, 158820.75, 159646.9184, 160470.4458, 161279.5348, 162093.3114, 162918.542, 163729.2842},
-
+	{24030.94, 24431.77, 24837.84, 25248.09, 25663.11, 26082.9, 26507.22, 26936.74, 27370.72, 27809.33, 28252.49, 28700.45, 29153.7, 29611.37, 30073.81, 30540.71, 31012.26, 31489.22, 31970.21, 32456.01, 32946.31, 33441.26, 33941.61, 34446.03, 34954.78, 35468.04, 35986.13, 36509.56, 37036.74, 37568.23, 38104.43, 38645.48, 39191.24, 39740.33, 40293.97, 40852.56, 41414.89, 41982.38, 42553.06, 43128.8, 43707.94, 44291.06, 44879.48, 45471.02, 46066.87, 46666.81, 47270.6, 47878.9, 48490.62, 49106.04, 49724.94, 50348.02, 50976.09, 51606.32, 52240.83, 52877.81, 53519.42, 54165.31, 54814.16, 55465.82, 56120.87, 56779.8, 57443.77, 58108.5, 58777.24, 59449.53, 60125, 60804.51, 61485.47, 62169.44, 62856.1, 63546.87, 64241.16, 64936.05, 65635.11, 66336.27, 67040.63, 67747.87, 68458.91, 69170.21, 69885.13, 70602.7, 71323.54, 72045.88, 72770.25, 73497.8, 74227.26, 74959.59, 75692.51, 76429.1, 77167.54, 77907.45, 78650.17, 79393.16, 80138.95, 80886.71, 81636.07, 82388.63, 83142.08, 83896.66, 84653.59, 85411.8, 86174.18, 86935.01, 87698.94, 88462.34, 89229.05, 89998.28, 90768.15, 91539.58, 92311.78, 93084.45, 93859.66, 94634.64, 95412.56, 96190.6, 96969.27, 97751.29, 98533.93, 99317.28, 100101.9, 100887.2, 101675.3, 102462.7, 103250.6, 104039, 104831.4, 105623.9, 106416.6, 107209.6, 108004, 108798.7, 109597.1, 110394.1, 111190.1, 111987.3, 112786.6, 113585.6, 114384.9, 115184.8, 115985.1, 116786.4, 117589.4, 118392.1, 119194.4, 119998.1, 120801.9, 121607, 122412, 123217.2, 124022.8, 124829.1, 125636.5, 126443.4, 127251.7, 128059.9, 128868.2, 129679.1, 130487.1, 131296.7, 132106.1, 132918, 133729.6, 134541.7, 135351.3, 136164.8, 136976.3, 137790.6, 138601.4, 139414.6, 140228.9, 141040, 141854.5, 142668.4, 143481.8, 144294.7, 145108.9, 145923.9, 146738.4, 147549.8, 148364.4, 149180.6, 149997.3, 150814.4, 151631.7, 152447.4, 153261.6, 154079.2, 154896.2, 155710.4, 156525.8, 157342.1, 158158.7, 158976.5, 159793, 160608.4, 161426.2, 162242.5, 163060.1, 163877},
 	// precision 16
-	{47271, 48062.3584, 48862.7074, 49673.152, 50492.8416, 51322.9514, 52161.03, 53009.407, 53867.6348, 54734.206, 55610.5144, 56496.2096, 57390.795, 58297.268, 59210.6448, 60134.665, 61068.0248, 62010.4472, 62962.5204, 63923.5742, 64895.0194, 65876.4182, 66862.6136, 67862.6968, 68868.8908, 69882.8544, 70911.271, 71944.0924, 72990.0326, 74040.692, 75100.6336, 76174.7826, 77252.5998, 78340.2974, 79438.2572, 80545.4976, 81657.2796, 82784.6336, 83915.515, 85059.7362, 86205.9368, 87364.4424, 88530.3358, 89707.3744, 90885.9638, 92080.197, 93275.5738, 94479.391, 95695.918, 96919.2236, 98148.4602, 99382.3474, 100625.6974, 101878.0284, 103141.6278, 104409.4588, 105686.2882, 106967.5402, 108261.6032, 109548.1578, 110852.0728, 112162.231, 113479.0072, 114806.2626, 116137.9072, 117469.5048, 118813.5186, 120165.4876, 121516.2556, 122875.766, 124250.5444, 125621.2222, 127003.2352, 128387.848, 129775.2644, 131181.7776, 132577.3086, 133979.9458, 135394.1132, 136800.9078, 138233.217, 139668.5308, 141085.212, 142535.2122, 143969.0684, 145420.2872, 146878.1542, 148332.7572, 149800.3202, 151269.66, 152743.6104, 154213.0948, 155690.288, 157169.4246, 158672.1756, 160160.059, 161650.6854, 163145.7772, 164645.6726, 166159.1952, 167682.1578, 169177.3328, 170700.0118, 172228.8964, 173732.6664, 175265.5556, 176787.799, 178317.111, 179856.6914, 181400.865, 182943.4612, 184486.742, 186033.4698, 187583.7886, 189148.1868, 190688.4526, 192250.1926, 193810.9042, 195354.2972, 196938.7682, 198493.5898, 200079.2824, 201618.912, 203205.5492, 204765.5798, 206356.1124, 207929.3064, 209498.7196, 211086.229, 212675.1324, 214256.7892, 215826.2392, 217412.8474, 218995.6724, 220618.6038, 222207.1166, 223781.0364, 225387.4332, 227005.7928, 228590.4336, 230217.8738, 231805.1054, 233408.9, 234995.3432, 236601.4956, 238190.7904, 239817.2548, 241411.2832, 243002.4066, 244640.1884, 246255.3128, 247849.3508, 249479.9734, 251106.8822, 252705.027, 254332.9242, 255935.129, 257526.9014, 259154.772, 260777.625, 262390.253, 264004.4906, 265643.59, 267255.4076, 268873.426, 270470.7252, 272106.4804, 273722.4456, 275337.794, 276945.7038, 278592.9154, 280204.3726, 281841.1606, 283489.171, 285130.1716, 286735.3362, 288364.7164, 289961.1814, 291595.5524, 293285.683, 294899.6668, 296499.3434, 298128.0462, 299761.8946, 301394.2424, 302997.6748, 304615.1478, 306269.7724, 307886.114, 309543.1028, 311153.2862, 312782.8546, 314421.2008, 316033.2438, 317692.9636, 319305.2648, 320948.7406, 322566.3364, 324228.4224, 325847.1542},
-
+	{48062.79, 48864.97, 49675.98, 50497.17, 51327.14, 52166.43, 53015.84, 53874.08, 54742.51, 55619.76, 56506.55, 57403.22, 58309.32, 59224.56, 60149.12, 61082.89, 62026.66, 62979.1, 63941.99, 64913.31, 65893.8, 66884.03, 67883.64, 68892.32, 69910.17, 70936.8, 71973.19, 73018.58, 74072.63, 75135.69, 76207.35, 77288.56, 78378.83, 79477.25, 80584.38, 81700.51, 82825.75, 83958.91, 85101.17, 86251.88, 87409.91, 88577.26, 89752.44, 90935.76, 92126.46, 93325.6, 94534.29, 95748.95, 96972.86, 98204.77, 99443.91, 100691.3, 101944.6, 103206.2, 104474.9, 105749.9, 107034.4, 108326.2, 109624.5, 110928.4, 112240.4, 113557.7, 114882.2, 116214.1, 117553, 118895.8, 120246, 121602.4, 122965.3, 124333.8, 125707.5, 127089.3, 128475, 129868.4, 131265.9, 132669.4, 134077.1, 135492.3, 136913.8, 138339.5, 139769, 141204.2, 142643.7, 144091, 145541.2, 146997.2, 148456.7, 149920.9, 151389.2, 152861.9, 154338, 155818.1, 157302.5, 158789.3, 160279.5, 161774.4, 163277.7, 164781.4, 166289, 167799.1, 169311.4, 170827.5, 172348.3, 173875.4, 175400.8, 176930.3, 178464.3, 180002.9, 181544.9, 183088.5, 184633.8, 186183.2, 187731.6, 189286.6, 190840.5, 192396.8, 193957.4, 195520.6, 197085, 198649.1, 200217.1, 201788.6, 203360.4, 204933.9, 206508.8, 208090.2, 209674, 211257.3, 212843.1, 214431.3, 216017.4, 217608.3, 219200.3, 220793.3, 222386.4, 223978, 225576.8, 227176.3, 228777.8, 230376.8, 231977.3, 233578.3, 235184.8, 236792.1, 238395.8, 240005.9, 241611.4, 243218.3, 244831.4, 246441.9, 248053.1, 249668.5, 251280.6, 252897.2, 254513.1, 256130, 257752.1, 259370.9, 260990.1, 262608.7, 264229.4, 265848.8, 267475.4, 269097.8, 270722.3, 272348.1, 273972.9, 275599.4, 277220.5, 278849.8, 280476, 282102, 283726.7, 285352.7, 286981.4, 288610.4, 290238.9, 291866.9, 293495.7, 295126.5, 296757.4, 298388.3, 300014, 301639.8, 303272.4, 304904.3, 306534.8, 308164.7, 309795.4, 311428.6, 313061.2, 314694.5, 316326.2, 317963.4, 319598.5, 321235.4, 322869.9, 324499.5, 326134.4, 327766.1},
 	// precision 17
-	{94542, 96125.811, 97728.019, 99348.558, 100987.9705, 102646.7565, 104324.5125, 106021.7435, 107736.7865, 109469.272, 111223.9465, 112995.219, 114787.432, 116593.152, 118422.71, 120267.2345, 122134.6765, 124020.937, 125927.2705, 127851.255, 129788.9485, 131751.016, 133726.8225, 135722.592, 137736.789, 139770.568, 141821.518, 143891.343, 145982.1415, 148095.387, 150207.526, 152355.649, 154515.6415, 156696.05, 158887.7575, 161098.159, 163329.852, 165569.053, 167837.4005, 170121.6165, 172420.4595, 174732.6265, 177062.77, 179412.502, 181774.035, 184151.939, 186551.6895, 188965.691, 191402.8095, 193857.949, 196305.0775, 198774.6715, 201271.2585, 203764.78, 206299.3695, 208818.1365, 211373.115, 213946.7465, 216532.076, 219105.541, 221714.5375, 224337.5135, 226977.5125, 229613.0655, 232270.2685, 234952.2065, 237645.3555, 240331.1925, 243034.517, 245756.0725, 248517.6865, 251232.737, 254011.3955, 256785.995, 259556.44, 262368.335, 265156.911, 267965.266, 270785.583, 273616.0495, 276487.4835, 279346.639, 282202.509, 285074.3885, 287942.2855, 290856.018, 293774.0345, 296678.5145, 299603.6355, 302552.6575, 305492.9785, 308466.8605, 311392.581, 314347.538, 317319.4295, 320285.9785, 323301.7325, 326298.3235, 329301.3105, 332301.987, 335309.791, 338370.762, 341382.923, 344431.1265, 347464.1545, 350507.28, 353619.2345, 356631.2005, 359685.203, 362776.7845, 365886.488, 368958.2255, 372060.6825, 375165.4335, 378237.935, 381328.311, 384430.5225, 387576.425, 390683.242, 393839.648, 396977.8425, 400101.9805, 403271.296, 406409.8425, 409529.5485, 412678.7, 415847.423, 419020.8035, 422157.081, 425337.749, 428479.6165, 431700.902, 434893.1915, 438049.582, 441210.5415, 444379.2545, 447577.356, 450741.931, 453959.548, 457137.0935, 460329.846, 463537.4815, 466732.3345, 469960.5615, 473164.681, 476347.6345, 479496.173, 482813.1645, 486025.6995, 489249.4885, 492460.1945, 495675.8805, 498908.0075, 502131.802, 505374.3855, 508550.9915, 511806.7305, 515026.776, 518217.0005, 521523.9855, 524705.9855, 527950.997, 531210.0265, 534472.497, 537750.7315, 540926.922, 544207.094, 547429.4345, 550666.3745, 553975.3475, 557150.7185, 560399.6165, 563662.697, 566916.7395, 570146.1215, 573447.425, 576689.6245, 579874.5745, 583202.337, 586503.0255, 589715.635, 592910.161, 596214.3885, 599488.035, 602740.92, 605983.0685, 609248.67, 612491.3605, 615787.912, 619107.5245, 622307.9555, 625577.333, 628840.4385, 632085.2155, 635317.6135, 638691.7195, 641887.467, 645139.9405, 648441.546, 651666.252, 654941.845},
-
+	{96127.04, 97730.99, 99353.11, 100995, 102655.8, 104334.6, 106033.1, 107749.6, 109486, 111241.2, 113016, 114809.2, 116620.1, 118450.5, 120300.8, 122168.9, 124055.5, 125961.5, 127885.8, 129829.9, 131792.4, 133772.7, 135771.8, 137788.2, 139824.7, 141879.5, 143952.4, 146041.8, 148150.1, 150277.4, 152421.1, 154583.8, 156761.5, 158960.4, 161175.2, 163407.9, 165657.9, 167925.5, 170210.5, 172511.3, 174830.2, 177165.6, 179515.8, 181883.6, 184267.2, 186667.6, 189083.7, 191513.5, 193958.7, 196423.4, 198902.3, 201396.3, 203904.1, 206433.7, 208971.4, 211525.1, 214090.3, 216670.9, 219266.5, 221876.7, 224497, 227134.9, 229785.3, 232447.1, 235122.5, 237813.2, 240514.6, 243228.8, 245957.4, 248697.7, 251449.6, 254213.2, 256988.4, 259774.7, 262570.3, 265374.6, 268191.7, 271022.4, 273859.4, 276708.8, 279569.2, 282440, 285321.6, 288213.4, 291113.5, 294020.7, 296937.8, 299860, 302793.6, 305738.5, 308692.8, 311653.1, 314616.5, 317593.1, 320578.8, 323572.7, 326574.3, 329581.1, 332595.4, 335616.2, 338640.6, 341678.4, 344718.2, 347764.2, 350818.2, 353877.1, 356948.5, 360027.1, 363103.6, 366187.4, 369277.8, 372371.2, 375477.6, 378582.5, 381690.4, 384809.5, 387928.5, 391058.3, 394187.6, 397320.5, 400462, 403606.9, 406753.8, 409908.2, 413063.7, 416225.6, 419383.7, 422548.9, 425716, 428889.2, 432063.9, 435241.1, 438419.4, 441609.1, 444796.4, 447987, 451179.1, 454374.4, 457573.4, 460776.9, 463979.2, 467192.5, 470399.2, 473612.4, 476825.3, 480043.9, 483255.5, 486478.1, 489693.8, 492914.8, 496138.9, 499362.8, 502600.6, 505834.5, 509065.3, 512297, 515526.1, 518769.2, 522006.3, 525245.3, 528487.6, 531729.3, 534970.4, 538218.1, 541466, 544707.5, 547953.4, 551201.2, 554446.8, 557695.9, 560953.5, 564204.7, 567460.1, 570712.6, 573962.1, 577220.9, 580480.1, 583733.4, 586993.3, 590256.1, 593511.2, 596776.6, 600043.4, 603307, 606568.6, 609834.2, 613095.4, 616364, 619623.1, 622893.1, 626154, 629413.9, 632670.9, 635938.5, 639197.1, 642466.2, 645737.1, 649006.5, 652277.8, 655549},
 	// precision 18
-	{189084, 192250.913, 195456.774, 198696.946, 201977.762, 205294.444, 208651.754, 212042.099, 215472.269, 218941.91, 222443.912, 225996.845, 229568.199, 233193.568, 236844.457, 240543.233, 244279.475, 248044.27, 251854.588, 255693.2, 259583.619, 263494.621, 267445.385, 271454.061, 275468.769, 279549.456, 283646.446, 287788.198, 291966.099, 296181.164, 300431.469, 304718.618, 309024.004, 313393.508, 317760.803, 322209.731, 326675.061, 331160.627, 335654.47, 340241.442, 344841.833, 349467.132, 354130.629, 358819.432, 363574.626, 368296.587, 373118.482, 377914.93, 382782.301, 387680.669, 392601.981, 397544.323, 402529.115, 407546.018, 412593.658, 417638.657, 422762.865, 427886.169, 433017.167, 438213.273, 443441.254, 448692.421, 453937.533, 459239.049, 464529.569, 469910.083, 475274.03, 480684.473, 486070.26, 491515.237, 496995.651, 502476.617, 507973.609, 513497.19, 519083.233, 524726.509, 530305.505, 535945.728, 541584.404, 547274.055, 552967.236, 558667.862, 564360.216, 570128.148, 575965.08, 581701.952, 587532.523, 593361.144, 599246.128, 605033.418, 610958.779, 616837.117, 622772.818, 628672.04, 634675.369, 640574.831, 646585.739, 652574.547, 658611.217, 664642.684, 670713.914, 676737.681, 682797.313, 688837.897, 694917.874, 701009.882, 707173.648, 713257.254, 719415.392, 725636.761, 731710.697, 737906.209, 744103.074, 750313.39, 756504.185, 762712.579, 768876.985, 775167.859, 781359, 787615.959, 793863.597, 800245.477, 806464.582, 812785.294, 819005.925, 825403.057, 831676.197, 837936.284, 844266.968, 850642.711, 856959.756, 863322.774, 869699.931, 876102.478, 882355.787, 888694.463, 895159.952, 901536.143, 907872.631, 914293.672, 920615.14, 927130.974, 933409.404, 939922.178, 946331.47, 952745.93, 959209.264, 965590.224, 972077.284, 978501.961, 984953.19, 991413.271, 997817.479, 1004222.658, 1010725.676, 1017177.138, 1023612.529, 1030098.236, 1036493.719, 1043112.207, 1049537.036, 1056008.096, 1062476.184, 1068942.337, 1075524.95, 1081932.864, 1088426.025, 1094776.005, 1101327.448, 1107901.673, 1114423.639, 1120884.602, 1127324.923, 1133794.24, 1140328.886, 1146849.376, 1153346.682, 1159836.502, 1166478.703, 1172953.304, 1179391.502, 1185950.982, 1192544.052, 1198913.41, 1205430.994, 1212015.525, 1218674.042, 1225121.683, 1231551.101, 1238126.379, 1244673.795, 1251260.649, 1257697.86, 1264320.983, 1270736.319, 1277274.694, 1283804.95, 1290211.514, 1296858.568, 1303455.691},
+	{192253.5, 195460.4, 198705.8, 201988.6, 205309.7, 208668.1, 212063.9, 215497.5, 218968.5, 222478.7, 226028.5, 229614.4, 233237.2, 236899.7, 240598.2, 244334.6, 248108.1, 251918.9, 255768, 259655.3, 263582.8, 267544, 271542.3, 275579.3, 279652.5, 283762.6, 287907.4, 292091.2, 296311.5, 300567.5, 304862, 309186.1, 313544.2, 317939.3, 322368.1, 326836.2, 331336.1, 335871, 340438.2, 345040.3, 349676.3, 354344.7, 359045.6, 363782, 368549.8, 373352.5, 378182.7, 383047.5, 387939.3, 392865.2, 397816.6, 402804.1, 407821.4, 412870.5, 417944.5, 423049.3, 428187.3, 433350.4, 438531, 443750.4, 449001.4, 454272.5, 459565.7, 464891.7, 470244.6, 475625.1, 481037.8, 486467.4, 491922.6, 497400, 502902.7, 508428, 513972.5, 519546.2, 525146.6, 530765.2, 536403.6, 542063.4, 547740, 553448.3, 559161.4, 564896.4, 570662.8, 576443.9, 582239.1, 588053.3, 593882.9, 599749.4, 605624.3, 611510.5, 617414.8, 623331.3, 629274.2, 635229.2, 641201.3, 647193.7, 653200.4, 659225.6, 665251.6, 671302.8, 677366.9, 683432.1, 689511.1, 695609.1, 701717.9, 707838.3, 713968, 720107.6, 726271.9, 732447.5, 738633.1, 744818.9, 751021.6, 757239.2, 763452.7, 769682.5, 775927.1, 782184.1, 788448.6, 794713.9, 800991.8, 807289.2, 813576.1, 819877.4, 826195.6, 832508.2, 838832.1, 845161.8, 851496, 857848.9, 864203.7, 870558.3, 876910.6, 883275.4, 889654.4, 896034.5, 902424.1, 908815.1, 915202.5, 921608.9, 928000.2, 934407.1, 940828.9, 947255.8, 953686.6, 960119, 966547.2, 972994.6, 979441.3, 985888.6, 992337.1, 998789.2, 1005244, 1011706, 1018169, 1024642, 1031092, 1037571, 1044048, 1050524, 1057000, 1063476, 1069966, 1076455, 1082951, 1089424, 1095910, 1102391, 1108883, 1115382, 1121902, 1128397, 1134917, 1141437, 1147945, 1154459, 1160980, 1167492, 1173990, 1180502, 1187014, 1193526, 1200050, 1206584, 1213100, 1219625, 1226147, 1232660, 1239174, 1245710, 1252226, 1258755, 1265312, 1271839, 1278358, 1284894, 1291426, 1297969, 1304494, 1311032},
+	// precision 19
+	{384509.3, 390924.7, 397415.7, 403981.5, 410621.9, 417338.9, 424132.5, 431002.8, 437946.3, 444966.8, 452061.8, 459233.2, 466478.7, 473800.6, 481199.6, 488671.4, 496219.6, 503844.2, 511540.5, 519311.9, 527156.9, 535081.9, 543074.6, 551145.8, 559289.1, 567501.7, 575792.8, 584155.2, 592592.4, 601100.4, 609674.1, 618325.9, 627038.6, 635817.4, 644679, 653608.1, 662605.8, 671665.8, 680801.5, 690004.7, 699272.9, 708612.5, 718011.2, 727481.4, 737016.3, 746619.1, 756279.3, 766003.8, 775788.5, 785646, 795554.5, 805536.7, 815571.2, 825666.6, 835822.9, 846024.9, 856295, 866625.3, 877007.9, 887435.1, 897934.7, 908473.2, 919066.8, 929708.5, 940405.4, 951163.4, 961967, 972825.3, 983730.7, 994675.4, 1005680, 1016740, 1027840, 1038988, 1050173, 1061389, 1072664, 1083982, 1095351, 1106740, 1118184, 1129666, 1141178, 1152737, 1164342, 1175981, 1187651, 1199364, 1211107, 1222870, 1234684, 1246541, 1258427, 1270350, 1282286, 1294267, 1306277, 1318312, 1330365, 1342451, 1354582, 1366731, 1378903, 1391099, 1403317, 1415569, 1427827, 1440130, 1452450, 1464804, 1477169, 1489566, 1501984, 1514410, 1526827, 1539286, 1551763, 1564265, 1576786, 1589329, 1601875, 1614453, 1627025, 1639619, 1652263, 1664882, 1677504, 1690174, 1702857, 1715531, 1728244, 1740955, 1753688, 1766418, 1779178, 1791962, 1804717, 1817487, 1830273, 1843102, 1855917, 1868715, 1881517, 1894369, 1907213, 1920087, 1932949, 1945840, 1958760, 1971657, 1984543, 1997457, 2010359, 2023239, 2036167, 2049089, 2062034, 2074970, 2087901, 2100872, 2113841, 2126810, 2139781, 2152743, 2165745, 2178735, 2191726, 2204700, 2217682, 2230697, 2243684, 2256656, 2269687, 2282740, 2295765, 2308789, 2321828, 2334850, 2347881, 2360926, 2373952, 2386989, 2400037, 2413077, 2426104, 2439137, 2452184, 2465263, 2478323, 2491398, 2504460, 2517489, 2530526, 2543594, 2556644, 2569714, 2582791, 2595880, 2608952, 2622008},
+	// precision 20
+	{769016.7, 781849, 794830.6, 807961.5, 821245.6, 834681.6, 848266.2, 862005, 875895.3, 889937.9, 904126.6, 918464.1, 932955.7, 947594.1, 962389.4, 977333.2, 992427, 1007676, 1023071, 1038621, 1054316, 1070166, 1086156, 1102291, 1118578, 1135016, 1151594, 1168316, 1185187, 1202200, 1219356, 1236660, 1254090, 1271665, 1289386, 1307247, 1325248, 1343377, 1361658, 1380061, 1398603, 1417279, 1436079, 1455014, 1474073, 1493252, 1512577, 1532031, 1551616, 1571313, 1591150, 1611085, 1631147, 1651336, 1671623, 1692041, 1712568, 1733228, 1753979, 1774851, 1795836, 1816936, 1838141, 1859453, 1880876, 1902370, 1923974, 1945684, 1967496, 1989413, 2011424, 2033523, 2055697, 2077989, 2100347, 2122819, 2145365, 2168003, 2190724, 2213534, 2236396, 2259371, 2282405, 2305532, 2328719, 2351984, 2375333, 2398752, 2422241, 2445805, 2469423, 2493110, 2516868, 2540665, 2564561, 2588506, 2612498, 2636558, 2660681, 2684843, 2709068, 2733359, 2757709, 2782107, 2806542, 2831008, 2855531, 2880117, 2904726, 2929396, 2954113, 2978885, 3003686, 3028524, 3053406, 3078334, 3103291, 3128289, 3153327, 3178419, 3203520, 3228658, 3253823, 3279018, 3304263, 3329523, 3354826, 3380153, 3405526, 3430908, 3456303, 3481727, 3507167, 3532630, 3558148, 3583670, 3609196, 3634769, 3660363, 3685980, 3711624, 3737247, 3762950, 3788642, 3814335, 3840072, 3865797, 3891579, 3917402, 3943169, 3969008, 3994844, 4020655, 4046496, 4072335, 4098185, 4124050, 4149946, 4175904, 4201818, 4227759, 4253687, 4279638, 4305612, 4331572, 4357537, 4383522, 4409528, 4435541, 4461568, 4487606, 4513594, 4539642, 4565647, 4591678, 4617713, 4643747, 4669780, 4695794, 4721884, 4747964, 4774060, 4800154, 4826249, 4852347, 4878450, 4904574, 4930705, 4956831, 4982953, 5009096, 5035231, 5061375, 5087506, 5113652, 5139787, 5165917, 5192074, 5218228, 5244353},
+	// precision 21
+	{1538037, 1563698, 1589662, 1615927, 1642497, 1669368, 1696538, 1724005, 1751779, 1779851, 1808237, 1836921, 1865904, 1895194, 1924785, 1954678, 1984876, 2015367, 2046163, 2077260, 2108646, 2140339, 2172323, 2204599, 2237163, 2270034, 2303184, 2336625, 2370362, 2404378, 2438697, 2473303, 2508176, 2543338, 2578793, 2614515, 2650508, 2686780, 2723317, 2760124, 2797204, 2834562, 2872168, 2910038, 2948165, 2986564, 3025190, 3064091, 3103252, 3142670, 3182321, 3222206, 3262336, 3302696, 3343303, 3384153, 3425210, 3466524, 3508043, 3549798, 3591745, 3633934, 3676338, 3718954, 3761778, 3804815, 3848027, 3891452, 3935066, 3978893, 4022862, 4067062, 4111434, 4156027, 4200766, 4245668, 4290752, 4336018, 4381469, 4427060, 4472826, 4518750, 4564874, 4611127, 4657506, 4704012, 4750723, 4797519, 4844499, 4891618, 4938859, 4986218, 5033687, 5081309, 5129068, 5176930, 5224907, 5273030, 5321279, 5369611, 5418057, 5466651, 5515344, 5564140, 5613032, 5662034, 5711121, 5760261, 5809499, 5858836, 5908274, 5957827, 6007466, 6057187, 6106948, 6156831, 6206779, 6256798, 6306858, 6357032, 6407268, 6457554, 6507878, 6558271, 6608745, 6659302, 6709916, 6760609, 6811346, 6862097, 6912889, 6963760, 7014657, 7065634, 7116673, 7167731, 7218833, 7269995, 7321162, 7372399, 7423695, 7474960, 7526299, 7577680, 7629096, 7680536, 7732026, 7783538, 7835148, 7886701, 7938330, 7989993, 8041651, 8093368, 8145124, 8196889, 8248653, 8300472, 8352288, 8404153, 8456022, 8507880, 8559759, 8611648, 8663577, 8715535, 8767539, 8819478, 8871453, 8923427, 8975425, 9027470, 9079567, 9131612, 9183649, 9235725, 9287825, 9339921, 9392076, 9444240, 9496374, 9548616, 9600721, 9652904, 9705099, 9757266, 9809514, 9861716, 9913920, 9966131, 1.001837e+07, 1.007061e+07, 1.012283e+07, 1.01751e+07, 1.022738e+07, 1.027962e+07, 1.033185e+07, 1.038413e+07, 1.043641e+07, 1.048875e+07},
+	// precision 22
+	{3076073, 3127394, 3179323, 3231854, 3284988, 3338722, 3393063, 3448008, 3503556, 3559714, 3616478, 3673844, 3731821, 3790397, 3849586, 3909374, 3969776, 4030768, 4092363, 4154547, 4217338, 4280696, 4344653, 4409204, 4474332, 4540072, 4606393, 4673292, 4740766, 4808799, 4877430, 4946624, 5016387, 5086721, 5157608, 5229041, 5301042, 5373584, 5446656, 5520272, 5594441, 5669138, 5744359, 5820107, 5896389, 5973170, 6050487, 6128299, 6206591, 6285382, 6364694, 6444480, 6524745, 6605511, 6686715, 6768373, 6850477, 6933079, 7016138, 7099629, 7183544, 7267919, 7352685, 7437881, 7523474, 7609547, 7695972, 7782827, 7870049, 7957666, 8045692, 8134069, 8222842, 8311996, 8401458, 8491271, 8581456, 8671994, 8762871, 8854076, 8945624, 9037428, 9129582, 9222032, 9314802, 9407868, 9501232, 9594867, 9688778, 9782956, 9877429, 9972162, 1.00672e+07, 1.01625e+07, 1.025802e+07, 1.035384e+07, 1.044988e+07, 1.054614e+07, 1.064265e+07, 1.073936e+07, 1.083625e+07, 1.09334e+07, 1.103078e+07, 1.112833e+07, 1.122613e+07, 1.132408e+07, 1.142222e+07, 1.152054e+07, 1.161911e+07, 1.171779e+07, 1.181669e+07, 1.191577e+07, 1.201491e+07, 1.211431e+07, 1.221388e+07, 1.231361e+07, 1.241344e+07, 1.251345e+07, 1.261362e+07, 1.271392e+07, 1.281436e+07, 1.291497e+07, 1.301567e+07, 1.311646e+07, 1.321738e+07, 1.331848e+07, 1.341968e+07, 1.352096e+07, 1.362236e+07, 1.372386e+07, 1.38255e+07, 1.392722e+07, 1.402909e+07, 1.413104e+07, 1.423304e+07, 1.433517e+07, 1.443738e+07, 1.453965e+07, 1.464203e+07, 1.474446e+07, 1.484699e+07, 1.49496e+07, 1.505222e+07, 1.515503e+07, 1.525791e+07, 1.536077e+07, 1.546369e+07, 1.556668e+07, 1.56697e+07, 1.577289e+07, 1.587613e+07, 1.597937e+07, 1.60827e+07, 1.618607e+07, 1.628952e+07, 1.639308e+07, 1.649657e+07, 1.66002e+07, 1.670379e+07, 1.680746e+07, 1.691123e+07, 1.701502e+07, 1.711883e+07, 1.722267e+07, 1.732648e+07, 1.74303e+07, 1.753422e+07, 1.763821e+07, 1.774223e+07, 1.784628e+07, 1.795033e+07, 1.805444e+07, 1.815856e+07, 1.826265e+07, 1.83668e+07, 1.8471e+07, 1.857513e+07, 1.867943e+07, 1.878366e+07, 1.888795e+07, 1.899218e+07, 1.909657e+07, 1.920087e+07, 1.930526e+07, 1.940971e+07, 1.951415e+07, 1.961858e+07, 1.9723e+07, 1.982741e+07, 1.993184e+07, 2.00363e+07, 2.014082e+07, 2.02453e+07, 2.034978e+07, 2.045433e+07, 2.055887e+07, 2.066342e+07, 2.0768e+07, 2.087258e+07, 2.097716e+07},
+	// precision 23
+	{6152152, 6254803, 6358659, 6463723, 6569988, 6677470, 6786162, 6896054, 7007164, 7119474, 7233003, 7347734, 7463678, 7580824, 7699188, 7818767, 7939549, 8061513, 8184699, 8309062, 8434636, 8561394, 8689335, 8818461, 8948754, 9080220, 9212838, 9346633, 9481572, 9617688, 9754935, 9893324, 1.003285e+07, 1.017351e+07, 1.031527e+07, 1.045814e+07, 1.060212e+07, 1.074723e+07, 1.089341e+07, 1.104067e+07, 1.118898e+07, 1.133839e+07, 1.148885e+07, 1.164035e+07, 1.179286e+07, 1.194644e+07, 1.210104e+07, 1.225663e+07, 1.241325e+07, 1.257083e+07, 1.272942e+07, 1.2889e+07, 1.304952e+07, 1.321099e+07, 1.337339e+07, 1.353676e+07, 1.370107e+07, 1.386628e+07, 1.403236e+07, 1.419929e+07, 1.436714e+07, 1.453586e+07, 1.470542e+07, 1.487583e+07, 1.50471e+07, 1.521918e+07, 1.539207e+07, 1.556574e+07, 1.57402e+07, 1.591548e+07, 1.609151e+07, 1.626827e+07, 1.644574e+07, 1.662395e+07, 1.680293e+07, 1.69826e+07, 1.716301e+07, 1.73441e+07, 1.752585e+07, 1.770826e+07, 1.78913e+07, 1.807501e+07, 1.825937e+07, 1.844429e+07, 1.862982e+07, 1.881601e+07, 1.900278e+07, 1.919017e+07, 1.937802e+07, 1.956649e+07, 1.97555e+07, 1.994504e+07, 2.013508e+07, 2.032561e+07, 2.051659e+07, 2.070808e+07, 2.090007e+07, 2.109253e+07, 2.12855e+07, 2.147887e+07, 2.167278e+07, 2.18671e+07, 2.206182e+07, 2.225701e+07, 2.245255e+07, 2.264846e+07, 2.284471e+07, 2.304142e+07, 2.323848e+07, 2.343592e+07, 2.363374e+07, 2.383188e+07, 2.403039e+07, 2.422919e+07, 2.442834e+07, 2.462784e+07, 2.482751e+07, 2.50276e+07, 2.522786e+07, 2.542841e+07, 2.562926e+07, 2.58304e+07, 2.603184e+07, 2.623351e+07, 2.643533e+07, 2.663742e+07, 2.683981e+07, 2.70424e+07, 2.724532e+07, 2.744846e+07, 2.765173e+07, 2.78552e+07, 2.80589e+07, 2.826274e+07, 2.84668e+07, 2.867097e+07, 2.887544e+07, 2.908009e+07, 2.928483e+07, 2.948975e+07, 2.969486e+07, 2.99002e+07, 3.010557e+07, 3.031103e+07, 3.051667e+07, 3.072247e+07, 3.092843e+07, 3.113451e+07, 3.134063e+07, 3.154693e+07, 3.175337e+07, 3.195988e+07, 3.216658e+07, 3.237335e+07, 3.258031e+07, 3.278727e+07, 3.29943e+07, 3.320156e+07, 3.340879e+07, 3.361605e+07, 3.382349e+07, 3.403095e+07, 3.423859e+07, 3.444626e+07, 3.465392e+07, 3.486171e+07, 3.506957e+07, 3.527747e+07, 3.548545e+07, 3.569344e+07, 3.590157e+07, 3.610963e+07, 3.631793e+07, 3.652631e+07, 3.673464e+07, 3.6943e+07, 3.715138e+07, 3.735983e+07, 3.756828e+07, 3.777675e+07, 3.798544e+07, 3.819409e+07, 3.840279e+07, 3.861145e+07, 3.882025e+07, 3.902902e+07, 3.923781e+07, 3.944664e+07, 3.96555e+07, 3.986441e+07, 4.007335e+07, 4.028246e+07, 4.049148e+07, 4.070047e+07, 4.09095e+07, 4.111861e+07, 4.132765e+07, 4.153682e+07, 4.174601e+07, 4.195519e+07},
+	// precision 24
+	{1.230429e+07, 1.25096e+07, 1.271731e+07, 1.292743e+07, 1.313997e+07, 1.335493e+07, 1.35723e+07, 1.379211e+07, 1.401432e+07, 1.423896e+07, 1.446603e+07, 1.469551e+07, 1.492741e+07, 1.516172e+07, 1.539845e+07, 1.563761e+07, 1.587917e+07, 1.612311e+07, 1.636946e+07, 1.661817e+07, 1.686928e+07, 1.712278e+07, 1.737864e+07, 1.763688e+07, 1.789748e+07, 1.816041e+07, 1.842567e+07, 1.869328e+07, 1.896321e+07, 1.923544e+07, 1.950992e+07, 1.97867e+07, 2.006572e+07, 2.0347e+07, 2.063053e+07, 2.091629e+07, 2.120427e+07, 2.149443e+07, 2.178679e+07, 2.208128e+07, 2.237793e+07, 2.267671e+07, 2.297763e+07, 2.328062e+07, 2.358576e+07, 2.389291e+07, 2.420214e+07, 2.451335e+07, 2.48266e+07, 2.514183e+07, 2.545901e+07, 2.577818e+07, 2.609925e+07, 2.642224e+07, 2.67471e+07, 2.707377e+07, 2.740228e+07, 2.77327e+07, 2.806481e+07, 2.839877e+07, 2.873443e+07, 2.907182e+07, 2.941101e+07, 2.975182e+07, 3.009436e+07, 3.043846e+07, 3.078427e+07, 3.113162e+07, 3.148055e+07, 3.183101e+07, 3.218304e+07, 3.253664e+07, 3.289168e+07, 3.324815e+07, 3.360608e+07, 3.396542e+07, 3.432612e+07, 3.468819e+07, 3.505172e+07, 3.541649e+07, 3.578259e+07, 3.615007e+07, 3.651866e+07, 3.688856e+07, 3.725975e+07, 3.763198e+07, 3.800545e+07, 3.838008e+07, 3.875581e+07, 3.913261e+07, 3.951058e+07, 3.988952e+07, 4.026951e+07, 4.065071e+07, 4.103287e+07, 4.141598e+07, 4.179992e+07, 4.218488e+07, 4.257078e+07, 4.295762e+07, 4.334538e+07, 4.373399e+07, 4.412339e+07, 4.451366e+07, 4.49047e+07, 4.529653e+07, 4.568915e+07, 4.608258e+07, 4.647665e+07, 4.687144e+07, 4.726694e+07, 4.766306e+07, 4.806003e+07, 4.845754e+07, 4.885578e+07, 4.925458e+07, 4.965403e+07, 5.005411e+07, 5.045477e+07, 5.0856e+07, 5.125767e+07, 5.165992e+07, 5.206269e+07, 5.246596e+07, 5.286984e+07, 5.327413e+07, 5.367892e+07, 5.40841e+07, 5.448969e+07, 5.489586e+07, 5.53023e+07, 5.570922e+07, 5.611643e+07, 5.652408e+07, 5.693216e+07, 5.734057e+07, 5.774934e+07, 5.815854e+07, 5.856795e+07, 5.897771e+07, 5.938785e+07, 5.979826e+07, 6.020902e+07, 6.061997e+07, 6.103122e+07, 6.144277e+07, 6.185458e+07, 6.226666e+07, 6.2679e+07, 6.309171e+07, 6.350449e+07, 6.391761e+07, 6.43308e+07, 6.474427e+07, 6.515809e+07, 6.557187e+07, 6.598594e+07, 6.640011e+07, 6.681458e+07, 6.722937e+07, 6.764414e+07, 6.805913e+07, 6.847425e+07, 6.888964e+07, 6.930507e+07, 6.972053e+07, 7.01362e+07, 7.055215e+07, 7.096814e+07, 7.138429e+07, 7.180041e+07, 7.221669e+07, 7.263315e+07, 7.304972e+07, 7.346645e+07, 7.388324e+07, 7.430011e+07, 7.471694e+07, 7.51339e+07, 7.555109e+07, 7.596829e+07, 7.638559e+07, 7.680299e+07, 7.722035e+07, 7.763798e+07, 7.80556e+07, 7.847339e+07, 7.889103e+07, 7.930876e+07, 7.97265e+07, 8.014453e+07, 8.056238e+07, 8.098039e+07, 8.139827e+07, 8.181637e+07, 8.223451e+07, 8.265279e+07, 8.3071e+07, 8.348921e+07, 8.390759e+07},
+	// precision 25
+	{2.460859e+07, 2.501918e+07, 2.543461e+07, 2.585487e+07, 2.627993e+07, 2.670985e+07, 2.714461e+07, 2.758421e+07, 2.802867e+07, 2.847795e+07, 2.893208e+07, 2.939104e+07, 2.985482e+07, 3.032347e+07, 3.079692e+07, 3.127518e+07, 3.175825e+07, 3.224616e+07, 3.273884e+07, 3.323631e+07, 3.373854e+07, 3.42455e+07, 3.475723e+07, 3.527366e+07, 3.579486e+07, 3.632071e+07, 3.685125e+07, 3.738645e+07, 3.792623e+07, 3.847063e+07, 3.90196e+07, 3.95731e+07, 4.01311e+07, 4.06937e+07, 4.126078e+07, 4.183227e+07, 4.24082e+07, 4.298852e+07, 4.357324e+07, 4.41623e+07, 4.475562e+07, 4.535323e+07, 4.595504e+07, 4.656103e+07, 4.71712e+07, 4.778551e+07, 4.840391e+07, 4.902638e+07, 4.965288e+07, 5.028326e+07, 5.091757e+07, 5.155586e+07, 5.219797e+07, 5.284395e+07, 5.349364e+07, 5.414703e+07, 5.480414e+07, 5.546483e+07, 5.612921e+07, 5.679705e+07, 5.746841e+07, 5.814327e+07, 5.882159e+07, 5.950339e+07, 6.018841e+07, 6.087678e+07, 6.156837e+07, 6.226317e+07, 6.296114e+07, 6.36621e+07, 6.436628e+07, 6.507346e+07, 6.578344e+07, 6.649631e+07, 6.72123e+07, 6.793107e+07, 6.865249e+07, 6.937669e+07, 7.010359e+07, 7.083315e+07, 7.156551e+07, 7.230036e+07, 7.303764e+07, 7.377746e+07, 7.45197e+07, 7.526439e+07, 7.601121e+07, 7.676046e+07, 7.751199e+07, 7.826572e+07, 7.902158e+07, 7.97797e+07, 8.053981e+07, 8.130186e+07, 8.206614e+07, 8.283224e+07, 8.360038e+07, 8.437026e+07, 8.514203e+07, 8.591551e+07, 8.669089e+07, 8.746799e+07, 8.82469e+07, 8.902744e+07, 8.980951e+07, 9.059311e+07, 9.137837e+07, 9.21652e+07, 9.295332e+07, 9.374293e+07, 9.453388e+07, 9.532623e+07, 9.611979e+07, 9.691494e+07, 9.771135e+07, 9.850905e+07, 9.930781e+07, 1.001078e+08, 1.00909e+08, 1.017112e+08, 1.025148e+08, 1.033193e+08, 1.041248e+08, 1.049314e+08, 1.057386e+08, 1.065471e+08, 1.073565e+08, 1.08167e+08, 1.089782e+08, 1.097904e+08, 1.106033e+08, 1.114172e+08, 1.122318e+08, 1.130472e+08, 1.138633e+08, 1.146802e+08, 1.154977e+08, 1.16316e+08, 1.171349e+08, 1.179546e+08, 1.18775e+08, 1.19596e+08, 1.204175e+08, 1.212396e+08, 1.220624e+08, 1.228857e+08, 1.237096e+08, 1.245339e+08, 1.253587e+08, 1.261838e+08, 1.270095e+08, 1.278356e+08, 1.286619e+08, 1.294888e+08, 1.303161e+08, 1.311439e+08, 1.319721e+08, 1.328007e+08, 1.336297e+08, 1.344587e+08, 1.352886e+08, 1.361185e+08, 1.369489e+08, 1.377796e+08, 1.386104e+08, 1.394414e+08, 1.402728e+08, 1.411044e+08, 1.419365e+08, 1.427687e+08, 1.436013e+08, 1.444338e+08, 1.452667e+08, 1.460998e+08, 1.469329e+08, 1.477662e+08, 1.485999e+08, 1.494337e+08, 1.502679e+08, 1.511021e+08, 1.519363e+08, 1.52771e+08, 1.536058e+08, 1.544407e+08, 1.552756e+08, 1.561107e+08, 1.569461e+08, 1.577815e+08, 1.586171e+08, 1.594528e+08, 1.602885e+08, 1.611245e+08, 1.619604e+08, 1.627967e+08, 1.636328e+08, 1.644691e+08, 1.653054e+08, 1.661419e+08, 1.669786e+08, 1.678154e+08},
 }
 
 var biasData = [][]float64{
 	// precision 4
-	{10, 9.717, 9.207, 8.7896, 8.2882, 7.8204, 7.3772, 6.9342, 6.5202, 6.161, 5.7722, 5.4636, 5.0396, 4.6766, 4.3566, 4.0454, 3.7936, 3.4856, 3.2666, 2.9946, 2.766, 2.4692, 2.3638, 2.0764, 1.7864, 1.7602, 1.4814, 1.433, 1.2926, 1.0664, 0.999600000000001, 0.7956, 0.5366, 0.589399999999998, 0.573799999999999, 0.269799999999996, 0.368200000000002, 0.0544000000000011, 0.234200000000001, 0.0108000000000033, -0.203400000000002, -0.0701999999999998, -0.129600000000003, -0.364199999999997, -0.480600000000003, -0.226999999999997, -0.322800000000001, -0.382599999999996, -0.511200000000002, -0.669600000000003, -0.749400000000001, -0.500399999999999, -0.617600000000003, -0.6922, -0.601599999999998, -0.416200000000003, -0.338200000000001, -0.782600000000002, -0.648600000000002, -0.919800000000002, -0.851799999999997, -0.962400000000002, -0.6402, -1.1922, -1.0256, -1.086, -1.21899999999999, -0.819400000000002, -0.940600000000003, -1.1554, -1.2072, -1.1752, -1.16759999999999, -1.14019999999999, -1.3754, -1.29859999999999, -1.607, -1.3292, -1.7606},
-
+	{10.23802, 9.72161, 9.220714, 8.735107, 8.263763, 7.814931, 7.377481, 6.951184, 6.545864, 6.157156, 5.782662, 5.424229, 5.090261, 4.761706, 4.452785, 4.151162, 3.871405, 3.611589, 3.350696, 3.11958, 2.900565, 2.691778, 2.48889, 2.291848, 2.100923, 1.934163, 1.772497, 1.60467, 1.470542, 1.333968, 1.222944, 1.097123, 0.9902811, 0.8998039, 0.8210583, 0.7338927, 0.6554844, 0.5811794, 0.5253715, 0.4758198, 0.4514301, 0.3840589, 0.3317464, 0.2796227, 0.2146471, 0.1849592, 0.1734994, 0.1316574, 0.08818908, 0.06456892, 0.0335152, 0.02087885, 0.02492208, 0.02944244, 0.01461603, 0.02906991, -0.007719322, 0.003288495, 0.001539162, 0.0433476, 0.009703879, 0.02812571, 0.01134222, -0.006468331, -0.01035073, -0.03106359, -0.04251112, -0.07808501, -0.09383747, -0.09824699, -0.1044873, -0.1152303, -0.1350325, -0.1470869, -0.1584488, -0.1685195, -0.1711855, -0.1759316, -0.2117609, -0.2145424},
 	// precision 5
-	{22, 21.1194, 20.8208, 20.2318, 19.77, 19.2436, 18.7774, 18.2848, 17.8224, 17.3742, 16.9336, 16.503, 16.0494, 15.6292, 15.2124, 14.798, 14.367, 13.9728, 13.5944, 13.217, 12.8438, 12.3696, 12.0956, 11.7044, 11.324, 11.0668, 10.6698, 10.3644, 10.049, 9.6918, 9.4146, 9.082, 8.687, 8.5398, 8.2462, 7.857, 7.6606, 7.4168, 7.1248, 6.9222, 6.6804, 6.447, 6.3454, 5.9594, 5.7636, 5.5776, 5.331, 5.19, 4.9676, 4.7564, 4.5314, 4.4442, 4.3708, 3.9774, 3.9624, 3.8796, 3.755, 3.472, 3.2076, 3.1024, 2.8908, 2.7338, 2.7728, 2.629, 2.413, 2.3266, 2.1524, 2.2642, 2.1806, 2.0566, 1.9192, 1.7598, 1.3516, 1.5802, 1.43859999999999, 1.49160000000001, 1.1524, 1.1892, 0.841399999999993, 0.879800000000003, 0.837599999999995, 0.469800000000006, 0.765600000000006, 0.331000000000003, 0.591399999999993, 0.601200000000006, 0.701599999999999, 0.558199999999999, 0.339399999999998, 0.354399999999998, 0.491200000000006, 0.308000000000007, 0.355199999999996, -0.0254000000000048, 0.205200000000005, -0.272999999999996, 0.132199999999997, 0.394400000000005, -0.241200000000006, 0.242000000000004, 0.191400000000002, 0.253799999999998, -0.122399999999999, -0.370800000000003, 0.193200000000004, -0.0848000000000013, 0.0867999999999967, -0.327200000000005, -0.285600000000002, 0.311400000000006, -0.128399999999999, -0.754999999999995, -0.209199999999996, -0.293599999999998, -0.364000000000004, -0.253600000000006, -0.821200000000005, -0.253600000000006, -0.510400000000004, -0.383399999999995, -0.491799999999998, -0.220200000000006, -0.0972000000000008, -0.557400000000001, -0.114599999999996, -0.295000000000002, -0.534800000000004, 0.346399999999988, -0.65379999999999, 0.0398000000000138, 0.0341999999999985, -0.995800000000003, -0.523400000000009, -0.489000000000004, -0.274799999999999, -0.574999999999989, -0.482799999999997, 0.0571999999999946, -0.330600000000004, -0.628800000000012, -0.140199999999993, -0.540600000000012, -0.445999999999998, -0.599400000000003, -0.262599999999992, 0.163399999999996, -0.100599999999986, -0.39500000000001, -1.06960000000001, -0.836399999999998, -0.753199999999993, -0.412399999999991, -0.790400000000005, -0.29679999999999, -0.28540000000001, -0.193000000000012, -0.0772000000000048, -0.962799999999987, -0.414800000000014},
-
+	{21.77942, 21.26281, 20.75297, 20.25259, 19.7588, 19.2696, 18.79073, 18.32038, 17.8576, 17.39979, 16.95315, 16.50893, 16.07886, 15.6534, 15.23523, 14.81912, 14.41825, 14.02934, 13.64237, 13.26048, 12.88612, 12.52353, 12.15932, 11.80201, 11.45473, 11.12321, 10.80073, 10.48579, 10.16335, 9.842096, 9.540068, 9.237874, 8.945309, 8.654363, 8.383891, 8.111693, 7.848803, 7.598302, 7.3414, 7.096452, 6.844794, 6.613626, 6.382513, 6.155524, 5.945544, 5.728452, 5.518662, 5.316408, 5.12584, 4.940261, 4.755486, 4.588504, 4.432077, 4.251974, 4.085332, 3.933252, 3.779721, 3.636368, 3.509452, 3.36755, 3.236909, 3.104282, 2.981693, 2.867408, 2.754427, 2.644151, 2.533307, 2.428055, 2.303746, 2.204757, 2.118301, 2.032083, 1.958516, 1.889368, 1.781396, 1.695195, 1.608631, 1.564066, 1.492003, 1.429037, 1.376886, 1.329737, 1.260376, 1.218893, 1.147273, 1.116031, 1.04308, 0.9824, 0.9128529, 0.9020177, 0.8460648, 0.850095, 0.8203941, 0.7589299, 0.7025843, 0.6620373, 0.5978436, 0.5514684, 0.5128838, 0.473771, 0.4451964, 0.4081893, 0.3732698, 0.3611332, 0.3510947, 0.3256929, 0.3121175, 0.305644, 0.2852558, 0.2492207, 0.2358243, 0.2150016, 0.2156969, 0.1998362, 0.2030688, 0.2009356, 0.1843941, 0.170053, 0.1732271, 0.124213, 0.125889, 0.08209755, 0.1040694, 0.07507729, 0.09453236, 0.08398938, 0.045966, 0.03594947, 0.0183729, 0.01019291, 0.0005956647, 0.005180519, -0.02253023, -0.03887879, -0.04609248, -0.04603996, -0.08984398, -0.09452162, -0.0875652, -0.09936507, -0.09057631, -0.1037854, -0.1027699, -0.1220998, -0.1177942, -0.1074138, -0.1287558, -0.1177757, -0.1470065, -0.1697229, -0.1741662, -0.1863323, -0.2185627, -0.2348517, -0.2078557, -0.2267995, -0.235481, -0.2662633, -0.2683339, -0.2899893},
 	// precision 6
-	{45, 44.1902, 43.271, 42.8358, 41.8142, 41.2854, 40.317, 39.354, 38.8924, 37.9436, 37.4596, 36.5262, 35.6248, 35.1574, 34.2822, 33.837, 32.9636, 32.074, 31.7042, 30.7976, 30.4772, 29.6564, 28.7942, 28.5004, 27.686, 27.291, 26.5672, 25.8556, 25.4982, 24.8204, 24.4252, 23.7744, 23.0786, 22.8344, 22.0294, 21.8098, 21.0794, 20.5732, 20.1878, 19.5648, 19.2902, 18.6784, 18.3352, 17.8946, 17.3712, 17.0852, 16.499, 16.2686, 15.6844, 15.2234, 14.9732, 14.3356, 14.2286, 13.7262, 13.3284, 13.1048, 12.5962, 12.3562, 12.1272, 11.4184, 11.4974, 11.0822, 10.856, 10.48, 10.2834, 10.0208, 9.637, 9.51739999999999, 9.05759999999999, 8.74760000000001, 8.42700000000001, 8.1326, 8.2372, 8.2788, 7.6776, 7.79259999999999, 7.1952, 6.9564, 6.6454, 6.87, 6.5428, 6.19999999999999, 6.02940000000001, 5.62780000000001, 5.6782, 5.792, 5.35159999999999, 5.28319999999999, 5.0394, 5.07480000000001, 4.49119999999999, 4.84899999999999, 4.696, 4.54040000000001, 4.07300000000001, 4.37139999999999, 3.7216, 3.7328, 3.42080000000001, 3.41839999999999, 3.94239999999999, 3.27719999999999, 3.411, 3.13079999999999, 2.76900000000001, 2.92580000000001, 2.68279999999999, 2.75020000000001, 2.70599999999999, 2.3886, 3.01859999999999, 2.45179999999999, 2.92699999999999, 2.41720000000001, 2.41139999999999, 2.03299999999999, 2.51240000000001, 2.5564, 2.60079999999999, 2.41720000000001, 1.80439999999999, 1.99700000000001, 2.45480000000001, 1.8948, 2.2346, 2.30860000000001, 2.15479999999999, 1.88419999999999, 1.6508, 0.677199999999999, 1.72540000000001, 1.4752, 1.72280000000001, 1.66139999999999, 1.16759999999999, 1.79300000000001, 1.00059999999999, 0.905200000000008, 0.659999999999997, 1.55879999999999, 1.1636, 0.688199999999995, 0.712600000000009, 0.450199999999995, 1.1978, 0.975599999999986, 0.165400000000005, 1.727, 1.19739999999999, -0.252600000000001, 1.13460000000001, 1.3048, 1.19479999999999, 0.313400000000001, 0.878999999999991, 1.12039999999999, 0.853000000000009, 1.67920000000001, 0.856999999999999, 0.448599999999999, 1.2362, 0.953399999999988, 1.02859999999998, 0.563199999999995, 0.663000000000011, 0.723000000000013, 0.756599999999992, 0.256599999999992, -0.837600000000009, 0.620000000000005, 0.821599999999989, 0.216600000000028, 0.205600000000004, 0.220199999999977, 0.372599999999977, 0.334400000000016, 0.928400000000011, 0.972800000000007, 0.192400000000021, 0.487199999999973, -0.413000000000011, 0.807000000000016, 0.120600000000024, 0.769000000000005, 0.870799999999974, 0.66500000000002, 0.118200000000002, 0.401200000000017, 0.635199999999998, 0.135400000000004, 0.175599999999974, 1.16059999999999, 0.34620000000001, 0.521400000000028, -0.586599999999976, -1.16480000000001, 0.968399999999974, 0.836999999999989, 0.779600000000016, 0.985799999999983},
-
+	{44.33548, 43.82122, 42.80319, 42.29776, 41.29967, 40.3172, 39.83217, 38.87058, 38.39589, 37.45909, 36.53549, 36.07524, 35.17225, 34.7297, 33.84924, 32.98514, 32.55779, 31.71169, 31.29554, 30.472, 29.66927, 29.2743, 28.49211, 28.10483, 27.34176, 26.59349, 26.2253, 25.50029, 25.14417, 24.42712, 23.73663, 23.39326, 22.72774, 22.38965, 21.7535, 21.11443, 20.8125, 20.20002, 19.89518, 19.30082, 18.72526, 18.43058, 17.87273, 17.59508, 17.05276, 16.52297, 16.25405, 15.73344, 15.48686, 14.99937, 14.51907, 14.28055, 13.81462, 13.58132, 13.12873, 12.67619, 12.45271, 12.032, 11.81633, 11.40966, 11.00363, 10.81666, 10.44127, 10.2699, 9.914307, 9.546193, 9.371323, 9.023155, 8.874017, 8.546555, 8.226987, 8.079026, 7.758494, 7.61678, 7.346745, 7.080692, 6.958265, 6.70375, 6.572366, 6.322655, 6.067632, 5.949325, 5.71474, 5.587111, 5.348637, 5.119575, 5.01289, 4.800835, 4.691233, 4.508089, 4.330919, 4.237578, 4.073798, 3.981628, 3.811896, 3.65225, 3.58544, 3.416416, 3.335355, 3.187761, 3.070898, 2.991186, 2.878024, 2.817243, 2.693669, 2.599157, 2.548963, 2.413261, 2.356196, 2.278486, 2.167623, 2.09528, 2.014713, 1.973919, 1.893895, 1.812462, 1.773439, 1.688969, 1.636167, 1.566705, 1.542459, 1.487621, 1.431267, 1.391888, 1.346187, 1.266456, 1.262222, 1.207376, 1.159014, 1.127362, 1.098219, 1.061564, 1.009776, 0.9837593, 0.8886182, 0.8525824, 0.8606771, 0.8340098, 0.8482889, 0.7915761, 0.7479416, 0.7187241, 0.7073999, 0.7277033, 0.6984605, 0.659873, 0.649059, 0.5943234, 0.6042259, 0.5330987, 0.5004892, 0.50217, 0.4493122, 0.4685186, 0.4539546, 0.4544753, 0.4497628, 0.3964496, 0.3929884, 0.4110151, 0.4073316, 0.400951, 0.3648694, 0.3795366, 0.3852328, 0.3281754, 0.2919846, 0.2591617, 0.245447, 0.282748, 0.2301411, 0.2270761, 0.1854221, 0.1574883, 0.195844, 0.2230065, 0.2346944, 0.2153116, 0.1779556, 0.1452853, 0.1812077, 0.1998025, 0.2243971, 0.2404766, 0.2399432, 0.3264654, 0.293218, 0.2957673, 0.2661914, 0.2634976, 0.2941884, 0.2791873, 0.206874, 0.2030698, 0.2128094, 0.2126157, 0.2078088, 0.1941101, 0.1647801, 0.1377303},
 	// precision 7
-	{91, 89.4934, 87.9758, 86.4574, 84.9718, 83.4954, 81.5302, 80.0756, 78.6374, 77.1782, 75.7888, 73.9522, 72.592, 71.2532, 69.9086, 68.5938, 66.9474, 65.6796, 64.4394, 63.2176, 61.9768, 60.4214, 59.2528, 58.0102, 56.8658, 55.7278, 54.3044, 53.1316, 52.093, 51.0032, 49.9092, 48.6306, 47.5294, 46.5756, 45.6508, 44.662, 43.552, 42.3724, 41.617, 40.5754, 39.7872, 38.8444, 37.7988, 36.8606, 36.2118, 35.3566, 34.4476, 33.5882, 32.6816, 32.0824, 31.0258, 30.6048, 29.4436, 28.7274, 27.957, 27.147, 26.4364, 25.7592, 25.3386, 24.781, 23.8028, 23.656, 22.6544, 21.996, 21.4718, 21.1544, 20.6098, 19.5956, 19.0616, 18.5758, 18.4878, 17.5244, 17.2146, 16.724, 15.8722, 15.5198, 15.0414, 14.941, 14.9048, 13.87, 13.4304, 13.028, 12.4708, 12.37, 12.0624, 11.4668, 11.5532, 11.4352, 11.2564, 10.2744, 10.2118, 9.74720000000002, 10.1456, 9.2928, 8.75040000000001, 8.55279999999999, 8.97899999999998, 8.21019999999999, 8.18340000000001, 7.3494, 7.32499999999999, 7.66140000000001, 6.90300000000002, 7.25439999999998, 6.9042, 7.21499999999997, 6.28640000000001, 6.08139999999997, 6.6764, 6.30099999999999, 5.13900000000001, 5.65800000000002, 5.17320000000001, 4.59019999999998, 4.9538, 5.08280000000002, 4.92200000000003, 4.99020000000002, 4.7328, 5.4538, 4.11360000000002, 4.22340000000003, 4.08780000000002, 3.70800000000003, 4.15559999999999, 4.18520000000001, 3.63720000000001, 3.68220000000002, 3.77960000000002, 3.6078, 2.49160000000001, 3.13099999999997, 2.5376, 3.19880000000001, 3.21100000000001, 2.4502, 3.52820000000003, 2.91199999999998, 3.04480000000001, 2.7432, 2.85239999999999, 2.79880000000003, 2.78579999999999, 1.88679999999999, 2.98860000000002, 2.50639999999999, 1.91239999999999, 2.66160000000002, 2.46820000000002, 1.58199999999999, 1.30399999999997, 2.27379999999999, 2.68939999999998, 1.32900000000001, 3.10599999999999, 1.69080000000002, 2.13740000000001, 2.53219999999999, 1.88479999999998, 1.33240000000001, 1.45119999999997, 1.17899999999997, 2.44119999999998, 1.60659999999996, 2.16700000000003, 0.77940000000001, 2.37900000000002, 2.06700000000001, 1.46000000000004, 2.91160000000002, 1.69200000000001, 0.954600000000028, 2.49300000000005, 2.2722, 1.33500000000004, 2.44899999999996, 1.20140000000004, 3.07380000000001, 2.09739999999999, 2.85640000000001, 2.29960000000005, 2.40899999999999, 1.97040000000004, 0.809799999999996, 1.65279999999996, 2.59979999999996, 0.95799999999997, 2.06799999999998, 2.32780000000002, 4.20159999999998, 1.96320000000003, 1.86400000000003, 1.42999999999995, 3.77940000000001, 1.27200000000005, 1.86440000000005, 2.20600000000002, 3.21900000000005, 1.5154, 2.61019999999996},
-
+	{89.99789, 88.45999, 86.43455, 84.93389, 83.44746, 81.97523, 80.51633, 78.60525, 77.19291, 75.80107, 74.41893, 73.05153, 71.24597, 69.91999, 68.61091, 67.31376, 66.03757, 64.3663, 63.13061, 61.91469, 60.70844, 59.51645, 57.95225, 56.79595, 55.66124, 54.5454, 53.45814, 52.02179, 50.96153, 49.90311, 48.86989, 47.86084, 46.53382, 45.56359, 44.58502, 43.63629, 42.69533, 41.44887, 40.55148, 39.66895, 38.77408, 37.91588, 36.79836, 35.97929, 35.16219, 34.37572, 33.59198, 32.56873, 31.81577, 31.10208, 30.3908, 29.6889, 28.75897, 28.0608, 27.38853, 26.74595, 26.0957, 25.21803, 24.59885, 24.01579, 23.44281, 22.85059, 22.12824, 21.58487, 21.06105, 20.51273, 20.01327, 19.36178, 18.88511, 18.40867, 17.92728, 17.4595, 16.82491, 16.39061, 15.95454, 15.5421, 15.13419, 14.5876, 14.21274, 13.83045, 13.49118, 13.13606, 12.67499, 12.34939, 11.97961, 11.64312, 11.30704, 10.88942, 10.58253, 10.3031, 10.00287, 9.762822, 9.379682, 9.094648, 8.857189, 8.642609, 8.40551, 8.08352, 7.840171, 7.635582, 7.419526, 7.239268, 6.941477, 6.76152, 6.568891, 6.403139, 6.199644, 5.94614, 5.765386, 5.609933, 5.45544, 5.308247, 5.095444, 4.981136, 4.817059, 4.64926, 4.515222, 4.314547, 4.190937, 4.083617, 3.978518, 3.81649, 3.642248, 3.561937, 3.460155, 3.40267, 3.292839, 3.142562, 3.019985, 2.922077, 2.838864, 2.741848, 2.630601, 2.581073, 2.479099, 2.395583, 2.268568, 2.151439, 2.078525, 1.978557, 1.926881, 1.834339, 1.699075, 1.675282, 1.655335, 1.582098, 1.550397, 1.451663, 1.381881, 1.292743, 1.255774, 1.20118, 1.192342, 1.150719, 1.11307, 1.094471, 1.065994, 1.040813, 0.9628468, 0.9633565, 0.9279861, 0.8580047, 0.8184049, 0.7813248, 0.731286, 0.6970683, 0.6636777, 0.5689827, 0.5353058, 0.4838959, 0.4400065, 0.4422288, 0.4458127, 0.4403449, 0.4326501, 0.3954587, 0.326431, 0.4027307, 0.3803734, 0.3906605, 0.3893722, 0.373681, 0.3347205, 0.3347876, 0.2974654, 0.2511184, 0.2090427, 0.1581971, 0.1779195, 0.174585, 0.1913606, 0.1912978, 0.2207993, 0.2040226, 0.1196541, 0.0686753, 0.07984842, 0.1179115, 0.1512154, 0.1136603},
 	// precision 8
-	{183.2152, 180.2454, 177.2096, 173.6652, 170.6312, 167.6822, 164.249, 161.3296, 158.0038, 155.2074, 152.4612, 149.27, 146.5178, 143.4412, 140.8032, 138.1634, 135.1688, 132.6074, 129.6946, 127.2664, 124.8228, 122.0432, 119.6824, 116.9464, 114.6268, 112.2626, 109.8376, 107.4034, 104.8956, 102.8522, 100.7638, 98.3552, 96.3556, 93.7526, 91.9292, 89.8954, 87.8198, 85.7668, 83.298, 81.6688, 79.9466, 77.9746, 76.1672, 74.3474, 72.3028, 70.8912, 69.114, 67.4646, 65.9744, 64.4092, 62.6022, 60.843, 59.5684, 58.1652, 56.5426, 55.4152, 53.5388, 52.3592, 51.1366, 49.486, 48.3918, 46.5076, 45.509, 44.3834, 43.3498, 42.0668, 40.7346, 40.1228, 38.4528, 37.7, 36.644, 36.0518, 34.5774, 33.9068, 32.432, 32.1666, 30.434, 29.6644, 28.4894, 27.6312, 26.3804, 26.292, 25.5496000000001, 25.0234, 24.8206, 22.6146, 22.4188, 22.117, 20.6762, 20.6576, 19.7864, 19.509, 18.5334, 17.9204, 17.772, 16.2924, 16.8654, 15.1836, 15.745, 15.1316, 15.0386, 14.0136, 13.6342, 12.6196, 12.1866, 12.4281999999999, 11.3324, 10.4794000000001, 11.5038, 10.129, 9.52800000000002, 10.3203999999999, 9.46299999999997, 9.79280000000006, 9.12300000000005, 8.74180000000001, 9.2192, 7.51020000000005, 7.60659999999996, 7.01840000000004, 7.22239999999999, 7.40139999999997, 6.76179999999999, 7.14359999999999, 5.65060000000005, 5.63779999999997, 5.76599999999996, 6.75139999999999, 5.57759999999996, 3.73220000000003, 5.8048, 5.63019999999995, 4.93359999999996, 3.47979999999995, 4.33879999999999, 3.98940000000005, 3.81960000000004, 3.31359999999995, 3.23080000000004, 3.4588, 3.08159999999998, 3.4076, 3.00639999999999, 2.38779999999997, 2.61900000000003, 1.99800000000005, 3.34820000000002, 2.95060000000001, 0.990999999999985, 2.11440000000005, 2.20299999999997, 2.82219999999995, 2.73239999999998, 2.7826, 3.76660000000004, 2.26480000000004, 2.31280000000004, 2.40819999999997, 2.75360000000001, 3.33759999999995, 2.71559999999999, 1.7478000000001, 1.42920000000004, 2.39300000000003, 2.22779999999989, 2.34339999999997, 0.87259999999992, 3.88400000000001, 1.80600000000004, 1.91759999999999, 1.16779999999994, 1.50320000000011, 2.52500000000009, 0.226400000000012, 2.31500000000005, 0.930000000000064, 1.25199999999995, 2.14959999999996, 0.0407999999999902, 2.5447999999999, 1.32960000000003, 0.197400000000016, 2.52620000000002, 3.33279999999991, -1.34300000000007, 0.422199999999975, 0.917200000000093, 1.12920000000008, 1.46060000000011, 1.45779999999991, 2.8728000000001, 3.33359999999993, -1.34079999999994, 1.57680000000005, 0.363000000000056, 1.40740000000005, 0.656600000000026, 0.801400000000058, -0.454600000000028, 1.51919999999996},
-
+	{180.7709, 177.1884, 174.1565, 170.666, 167.7016, 164.7652, 161.3877, 158.5311, 155.2437, 152.4567, 149.7081, 146.5393, 143.8544, 140.7576, 138.1339, 135.5614, 132.5969, 130.0868, 127.1924, 124.7485, 122.3282, 119.5525, 117.2176, 114.5168, 112.2463, 109.9932, 107.4251, 105.2496, 102.7591, 100.6582, 98.5718, 96.19368, 94.1859, 91.8907, 89.93945, 88.03259, 85.84157, 84.00679, 81.88771, 80.0933, 78.31254, 76.28223, 74.56754, 72.59373, 70.97224, 69.42065, 67.60385, 66.07675, 64.30134, 62.8089, 61.3655, 59.69227, 58.27922, 56.66168, 55.31571, 54.03339, 52.5033, 51.26517, 49.84171, 48.59237, 47.39996, 46.01852, 44.87863, 43.56498, 42.47084, 41.40724, 40.15183, 39.12529, 37.96968, 36.99945, 36.03391, 34.92969, 34.0292, 32.91708, 32.03605, 31.17248, 30.16663, 29.38683, 28.5093, 27.76551, 27.01915, 26.08444, 25.32488, 24.53226, 23.85624, 23.21086, 22.4555, 21.84666, 21.09367, 20.46773, 19.9284, 19.23477, 18.69538, 18.03811, 17.51827, 17.04006, 16.47985, 15.96358, 15.3902, 14.92475, 14.49365, 13.93366, 13.48941, 12.98661, 12.52281, 12.12769, 11.71644, 11.33497, 10.93154, 10.56263, 10.198, 9.825174, 9.536493, 9.1378, 8.790114, 8.507367, 8.147668, 7.873566, 7.612621, 7.388549, 7.175054, 6.879684, 6.59569, 6.293868, 6.039563, 5.859624, 5.622408, 5.49197, 5.293227, 5.096439, 4.940636, 4.728839, 4.525604, 4.316825, 4.163249, 4.028228, 3.860715, 3.793411, 3.673789, 3.546338, 3.43809, 3.396862, 3.324304, 3.177858, 3.100178, 3.045674, 2.984781, 2.968715, 2.838045, 2.696713, 2.62155, 2.473282, 2.321893, 2.309214, 2.242626, 2.182208, 2.136955, 2.159515, 2.193597, 2.218831, 2.128803, 2.099279, 2.08009, 1.93551, 1.854493, 1.854399, 1.815298, 1.928875, 1.774778, 1.670942, 1.640374, 1.584067, 1.521657, 1.568474, 1.467774, 1.364763, 1.338548, 1.259857, 1.188831, 1.183936, 1.145621, 1.114697, 1.17185, 1.15514, 1.144118, 1.047875, 1.03016, 1.011984, 0.8731334, 0.8417614, 0.8249023, 0.7735778, 0.7493182, 0.7610891, 0.7295278, 0.662091, 0.5918673, 0.5735128, 0.5743708, 0.6390997},
 	// precision 9
-	{368, 361.8294, 355.2452, 348.6698, 342.1464, 336.2024, 329.8782, 323.6598, 317.462, 311.2826, 305.7102, 299.7416, 293.9366, 288.1046, 282.285, 277.0668, 271.306, 265.8448, 260.301, 254.9886, 250.2422, 244.8138, 239.7074, 234.7428, 229.8402, 225.1664, 220.3534, 215.594, 210.6886, 205.7876, 201.65, 197.228, 192.8036, 188.1666, 184.0818, 180.0824, 176.2574, 172.302, 168.1644, 164.0056, 160.3802, 156.7192, 152.5234, 149.2084, 145.831, 142.485, 139.1112, 135.4764, 131.76, 129.3368, 126.5538, 122.5058, 119.2646, 116.5902, 113.3818, 110.8998, 107.9532, 105.2062, 102.2798, 99.4728, 96.9582, 94.3292, 92.171, 89.7809999999999, 87.5716, 84.7048, 82.5322, 79.875, 78.3972, 75.3464, 73.7274, 71.2834, 70.1444, 68.4263999999999, 66.0166, 64.018, 62.0437999999999, 60.3399999999999, 58.6856, 57.9836, 55.0311999999999, 54.6769999999999, 52.3188, 51.4846, 49.4423999999999, 47.739, 46.1487999999999, 44.9202, 43.4059999999999, 42.5342000000001, 41.2834, 38.8954000000001, 38.3286000000001, 36.2146, 36.6684, 35.9946, 33.123, 33.4338, 31.7378000000001, 29.076, 28.9692, 27.4964, 27.0998, 25.9864, 26.7754, 24.3208, 23.4838, 22.7388000000001, 24.0758000000001, 21.9097999999999, 20.9728, 19.9228000000001, 19.9292, 16.617, 17.05, 18.2996000000001, 15.6128000000001, 15.7392, 14.5174, 13.6322, 12.2583999999999, 13.3766000000001, 11.423, 13.1232, 9.51639999999998, 10.5938000000001, 9.59719999999993, 8.12220000000002, 9.76739999999995, 7.50440000000003, 7.56999999999994, 6.70440000000008, 6.41419999999994, 6.71019999999999, 5.60940000000005, 4.65219999999999, 6.84099999999989, 3.4072000000001, 3.97859999999991, 3.32760000000007, 5.52160000000003, 3.31860000000006, 2.06940000000009, 4.35400000000004, 1.57500000000005, 0.280799999999999, 2.12879999999996, -0.214799999999968, -0.0378000000000611, -0.658200000000079, 0.654800000000023, -0.0697999999999865, 0.858400000000074, -2.52700000000004, -2.1751999999999, -3.35539999999992, -1.04019999999991, -0.651000000000067, -2.14439999999991, -1.96659999999997, -3.97939999999994, -0.604400000000169, -3.08260000000018, -3.39159999999993, -5.29640000000018, -5.38920000000007, -5.08759999999984, -4.69900000000007, -5.23720000000003, -3.15779999999995, -4.97879999999986, -4.89899999999989, -7.48880000000008, -5.94799999999987, -5.68060000000014, -6.67180000000008, -4.70499999999993, -7.27779999999984, -4.6579999999999, -4.4362000000001, -4.32139999999981, -5.18859999999995, -6.66879999999992, -6.48399999999992, -5.1260000000002, -4.4032000000002, -6.13500000000022, -5.80819999999994, -4.16719999999987, -4.15039999999999, -7.45600000000013, -7.24080000000004, -9.83179999999993, -5.80420000000004, -8.6561999999999, -6.99940000000015, -10.5473999999999, -7.34139999999979, -6.80999999999995, -6.29719999999998, -6.23199999999997},
-
+	{361.8085, 355.1668, 349.1031, 342.6153, 336.1963, 329.8564, 323.5908, 317.873, 311.7515, 305.7042, 299.7382, 293.8302, 288.4638, 282.7246, 277.0693, 271.4785, 265.9531, 260.9448, 255.5635, 250.2659, 245.038, 239.9024, 235.2128, 230.2387, 225.3226, 220.46, 215.6772, 211.3372, 206.7143, 202.1614, 197.6764, 193.2899, 189.2678, 184.9901, 180.7488, 176.6302, 172.5595, 168.8544, 164.9135, 161.0425, 157.2624, 153.5287, 150.1268, 146.542, 143.0299, 139.5356, 136.1135, 133.0084, 129.7378, 126.5114, 123.3866, 120.2579, 117.3961, 114.4128, 111.5099, 108.6509, 105.8677, 103.2778, 100.5477, 97.91095, 95.28612, 92.76035, 90.43878, 87.96484, 85.56466, 83.18663, 80.92584, 78.84526, 76.68207, 74.56306, 72.44365, 70.45359, 68.53899, 66.67029, 64.84644, 62.9976, 61.17235, 59.50336, 57.78352, 56.14017, 54.4944, 52.90952, 51.42515, 49.90447, 48.44063, 46.99456, 45.69194, 44.48451, 43.14315, 41.84077, 40.59023, 39.3079, 38.15124, 36.96315, 35.80699, 34.62926, 33.53762, 32.58994, 31.5809, 30.62738, 29.66997, 28.75436, 27.85898, 26.94613, 26.06124, 25.2145, 24.35462, 23.6665, 22.99153, 22.37546, 21.56352, 20.88334, 20.21952, 19.5166, 18.84766, 18.27024, 17.69019, 17.08403, 16.56774, 16.01106, 15.51766, 14.99112, 14.50767, 14.01499, 13.7062, 13.24187, 12.7573, 12.47646, 12.16094, 11.77151, 11.42777, 10.99769, 10.54035, 10.2044, 9.777858, 9.431795, 9.061537, 8.730904, 8.472807, 8.169535, 7.775778, 7.539299, 7.312426, 7.107821, 6.72998, 6.497781, 6.304574, 6.007784, 5.784295, 5.623859, 5.39081, 5.031016, 4.88947, 4.704571, 4.568585, 4.399939, 4.157297, 4.024119, 3.915959, 3.857441, 3.828797, 3.783253, 3.607244, 3.436307, 3.30979, 3.16092, 3.026584, 2.883392, 2.858083, 2.797203, 2.798201, 2.830319, 2.789429, 2.573386, 2.448509, 2.350622, 2.402944, 2.36639, 2.285933, 2.169722, 2.126909, 2.040735, 1.925293, 1.823966, 1.887118, 1.836597, 1.882197, 1.767269, 1.663449, 1.780958, 1.79669, 1.751751, 1.661166, 1.736179, 1.741696, 1.715753, 1.601471, 1.664641, 1.780594, 1.785508},
 	// precision 10
-	{737.1256, 724.4234, 711.1064, 698.4732, 685.4636, 673.0644, 660.488, 647.9654, 636.0832, 623.7864, 612.1992, 600.2176, 588.5228, 577.1716, 565.7752, 554.899, 543.6126, 532.6492, 521.9474, 511.5214, 501.1064, 490.6364, 480.2468, 470.4588, 460.3832, 451.0584, 440.8606, 431.3868, 422.5062, 413.1862, 404.463, 395.339, 386.1936, 378.1292, 369.1854, 361.2908, 353.3324, 344.8518, 337.5204, 329.4854, 321.9318, 314.552, 306.4658, 299.4256, 292.849, 286.152, 278.8956, 271.8792, 265.118, 258.62, 252.5132, 245.9322, 239.7726, 233.6086, 227.5332, 222.5918, 216.4294, 210.7662, 205.4106, 199.7338, 194.9012, 188.4486, 183.1556, 178.6338, 173.7312, 169.6264, 163.9526, 159.8742, 155.8326, 151.1966, 147.5594, 143.07, 140.037, 134.1804, 131.071, 127.4884, 124.0848, 120.2944, 117.333, 112.9626, 110.2902, 107.0814, 103.0334, 99.4832000000001, 96.3899999999999, 93.7202000000002, 90.1714000000002, 87.2357999999999, 85.9346, 82.8910000000001, 80.0264000000002, 78.3834000000002, 75.1543999999999, 73.8683999999998, 70.9895999999999, 69.4367999999999, 64.8701999999998, 65.0408000000002, 61.6738, 59.5207999999998, 57.0158000000001, 54.2302, 53.0962, 50.4985999999999, 52.2588000000001, 47.3914, 45.6244000000002, 42.8377999999998, 43.0072, 40.6516000000001, 40.2453999999998, 35.2136, 36.4546, 33.7849999999999, 33.2294000000002, 32.4679999999998, 30.8670000000002, 28.6507999999999, 28.9099999999999, 27.5983999999999, 26.1619999999998, 24.5563999999999, 23.2328000000002, 21.9484000000002, 21.5902000000001, 21.3346000000001, 17.7031999999999, 20.6111999999998, 19.5545999999999, 15.7375999999999, 17.0720000000001, 16.9517999999998, 15.326, 13.1817999999998, 14.6925999999999, 13.0859999999998, 13.2754, 10.8697999999999, 11.248, 7.3768, 4.72339999999986, 7.97899999999981, 8.7503999999999, 7.68119999999999, 9.7199999999998, 7.73919999999998, 5.6224000000002, 7.44560000000001, 6.6601999999998, 5.9058, 4.00199999999995, 4.51699999999983, 4.68240000000014, 3.86220000000003, 5.13639999999987, 5.98500000000013, 2.47719999999981, 2.61999999999989, 1.62800000000016, 4.65000000000009, 0.225599999999758, 0.831000000000131, -0.359400000000278, 1.27599999999984, -2.92559999999958, -0.0303999999996449, 2.37079999999969, -2.0033999999996, 0.804600000000391, 0.30199999999968, 1.1247999999996, -2.6880000000001, 0.0321999999996478, -1.18099999999959, -3.9402, -1.47940000000017, -0.188400000000001, -2.10720000000038, -2.04159999999956, -3.12880000000041, -4.16160000000036, -0.612799999999879, -3.48719999999958, -8.17900000000009, -5.37780000000021, -4.01379999999972, -5.58259999999973, -5.73719999999958, -7.66799999999967, -5.69520000000011, -1.1247999999996, -5.58520000000044, -8.04560000000038, -4.64840000000004, -11.6468000000004, -7.97519999999986, -5.78300000000036, -7.67420000000038, -10.6328000000003, -9.81720000000041},
-
+	{724.4191, 711.652, 698.5165, 686.0395, 673.2018, 660.5194, 648.4691, 636.0822, 624.3229, 612.2493, 600.3389, 589.0165, 577.3868, 566.3446, 555.0148, 543.8363, 533.2466, 522.3879, 512.0757, 501.4815, 491.0479, 481.137, 470.9761, 461.346, 451.5034, 441.8251, 432.6348, 423.2042, 414.27, 405.1714, 396.1792, 387.6788, 379.0418, 370.7693, 362.3603, 354.0631, 346.21, 338.1728, 330.594, 322.773, 315.1401, 307.9737, 300.6277, 293.6631, 286.596, 279.6434, 273.1072, 266.4286, 260.103, 253.6473, 247.2844, 241.289, 235.0815, 229.3108, 223.412, 217.6846, 212.2932, 206.7461, 201.5839, 196.2918, 191.1444, 186.2558, 181.2634, 176.5757, 171.8636, 167.1892, 162.8126, 158.2346, 154.1584, 149.9266, 145.788, 141.9378, 138.0079, 134.2037, 130.3961, 126.6978, 123.1756, 119.6892, 116.4408, 113.1085, 109.7756, 106.6318, 103.4846, 100.5227, 97.56284, 94.74032, 91.96298, 89.25791, 86.59121, 83.90154, 81.33783, 78.98173, 76.56644, 74.31911, 72.05241, 69.82957, 67.65625, 65.45265, 63.40879, 61.47639, 59.42053, 57.57956, 55.75471, 54.13593, 52.36364, 50.682, 49.1598, 47.67692, 46.25543, 44.66491, 43.16254, 41.59465, 40.23829, 39.03693, 37.67456, 36.39059, 35.25868, 34.10932, 33.07687, 31.95902, 30.88084, 29.87223, 28.93815, 27.87878, 27.06587, 26.14075, 25.30115, 24.41328, 23.68639, 22.79905, 22.1462, 21.40546, 20.89347, 20.21722, 19.63668, 18.86489, 18.19948, 17.56425, 16.84488, 16.22008, 15.63793, 14.91834, 14.33178, 13.78043, 13.14181, 12.65514, 12.07257, 11.62694, 11.15053, 10.6001, 10.27974, 10.00451, 9.725666, 9.277584, 8.878597, 8.383066, 8.177344, 7.849241, 7.529301, 7.109646, 6.96164, 6.713033, 6.538195, 6.423833, 6.161144, 5.937755, 5.755339, 5.581581, 5.293539, 5.236597, 5.196079, 4.943833, 4.776081, 4.636115, 4.666822, 4.444519, 4.247779, 3.996517, 3.759059, 3.53827, 3.395576, 3.307954, 3.165017, 3.175455, 3.073936, 2.98561, 3.005888, 2.878031, 2.891802, 2.731933, 2.579502, 2.328829, 2.288579, 2.251954, 2.174609, 2.199974, 2.091443, 2.020146, 1.945, 1.803096},
 	// precision 11
-	{1476, 1449.6014, 1423.5802, 1397.7942, 1372.3042, 1347.2062, 1321.8402, 1297.2292, 1272.9462, 1248.9926, 1225.3026, 1201.4252, 1178.0578, 1155.6092, 1132.626, 1110.5568, 1088.527, 1066.5154, 1045.1874, 1024.3878, 1003.37, 982.1972, 962.5728, 942.1012, 922.9668, 903.292, 884.0772, 864.8578, 846.6562, 828.041, 809.714, 792.3112, 775.1806, 757.9854, 740.656, 724.346, 707.5154, 691.8378, 675.7448, 659.6722, 645.5722, 630.1462, 614.4124, 600.8728, 585.898, 572.408, 558.4926, 544.4938, 531.6776, 517.282, 505.7704, 493.1012, 480.7388, 467.6876, 456.1872, 445.5048, 433.0214, 420.806, 411.409, 400.4144, 389.4294, 379.2286, 369.651, 360.6156, 350.337, 342.083, 332.1538, 322.5094, 315.01, 305.6686, 298.1678, 287.8116, 280.9978, 271.9204, 265.3286, 257.5706, 249.6014, 242.544, 235.5976, 229.583, 220.9438, 214.672, 208.2786, 201.8628, 195.1834, 191.505, 186.1816, 178.5188, 172.2294, 167.8908, 161.0194, 158.052, 151.4588, 148.1596, 143.4344, 138.5238, 133.13, 127.6374, 124.8162, 118.7894, 117.3984, 114.6078, 109.0858, 105.1036, 103.6258, 98.6018000000004, 95.7618000000002, 93.5821999999998, 88.5900000000001, 86.9992000000002, 82.8800000000001, 80.4539999999997, 74.6981999999998, 74.3644000000004, 73.2914000000001, 65.5709999999999, 66.9232000000002, 65.1913999999997, 62.5882000000001, 61.5702000000001, 55.7035999999998, 56.1764000000003, 52.7596000000003, 53.0302000000001, 49.0609999999997, 48.4694, 44.933, 46.0474000000004, 44.7165999999997, 41.9416000000001, 39.9207999999999, 35.6328000000003, 35.5276000000003, 33.1934000000001, 33.2371999999996, 33.3864000000003, 33.9228000000003, 30.2371999999996, 29.1373999999996, 25.2272000000003, 24.2942000000003, 19.8338000000003, 18.9005999999999, 23.0907999999999, 21.8544000000002, 19.5176000000001, 15.4147999999996, 16.9314000000004, 18.6737999999996, 12.9877999999999, 14.3688000000002, 12.0447999999997, 15.5219999999999, 12.5299999999997, 14.5940000000001, 14.3131999999996, 9.45499999999993, 12.9441999999999, 3.91139999999996, 13.1373999999996, 5.44720000000052, 9.82779999999912, 7.87279999999919, 3.67760000000089, 5.46980000000076, 5.55099999999948, 5.65979999999945, 3.89439999999922, 3.1275999999998, 5.65140000000065, 6.3062000000009, 3.90799999999945, 1.87060000000019, 5.17020000000048, 2.46680000000015, 0.770000000000437, -3.72340000000077, 1.16400000000067, 8.05340000000069, 0.135399999999208, 2.15940000000046, 0.766999999999825, 1.0594000000001, 3.15500000000065, -0.287399999999252, 2.37219999999979, -2.86620000000039, -1.63199999999961, -2.22979999999916, -0.15519999999924, -1.46039999999994, -0.262199999999211, -2.34460000000036, -2.8078000000005, -3.22179999999935, -5.60159999999996, -8.42200000000048, -9.43740000000071, 0.161799999999857, -10.4755999999998, -10.0823999999993},
-
+	{1450.107, 1424.058, 1397.787, 1372.34, 1347.171, 1322.324, 1297.74, 1272.978, 1248.999, 1225.287, 1201.901, 1178.81, 1155.525, 1133.026, 1110.792, 1088.888, 1067.235, 1045.456, 1024.42, 1003.666, 983.1929, 962.9884, 942.7074, 923.0876, 903.7748, 884.7488, 866.031, 847.1884, 828.9978, 811.1114, 793.508, 776.166, 758.727, 741.9007, 725.3278, 709.0589, 693.0636, 677.0663, 661.5898, 646.3892, 631.43, 616.7657, 602.1037, 587.8909, 574.0301, 560.4189, 547.0781, 533.6507, 520.8095, 508.2164, 495.782, 483.5571, 471.3101, 459.6455, 448.0802, 436.716, 425.683, 414.6234, 403.9979, 393.6301, 383.4508, 373.529, 363.5915, 353.9209, 344.6335, 335.4208, 326.4349, 317.5026, 308.9454, 300.6666, 292.3835, 284.3627, 276.3684, 268.7348, 261.3085, 253.9349, 246.7515, 239.5491, 232.7432, 226.033, 219.535, 213.1266, 206.9318, 201.0451, 195.0261, 189.3745, 183.651, 178.0468, 172.7158, 167.3596, 162.326, 157.483, 152.6729, 148.0717, 143.5007, 139.1907, 135.0457, 130.8867, 126.6757, 122.7323, 118.902, 115.1929, 111.3176, 107.6634, 104.1923, 100.767, 97.52334, 94.24481, 91.10959, 88.32557, 85.33843, 82.4521, 79.73911, 77.08124, 74.67824, 72.20808, 69.78109, 67.29322, 64.83966, 62.54132, 60.57058, 58.36547, 56.5035, 54.62218, 52.54723, 50.72702, 48.98925, 47.37427, 45.75171, 44.2791, 42.85166, 41.16284, 39.66316, 38.44848, 36.87895, 35.61399, 34.19986, 32.91942, 31.81957, 30.58365, 29.39446, 28.2857, 27.04576, 25.88372, 24.89253, 24.26717, 23.23767, 22.31075, 21.65194, 20.78565, 19.99313, 19.59491, 18.98179, 18.21272, 17.34503, 16.61891, 16.03896, 15.45467, 14.8251, 14.22392, 13.45907, 12.94887, 12.36597, 12.01635, 11.5666, 10.82417, 10.60509, 9.866184, 9.363363, 8.792261, 8.292922, 8.171572, 7.945473, 7.474816, 6.86116, 6.266606, 6.328034, 5.922781, 5.728808, 5.468108, 5.259379, 5.181327, 4.628393, 4.514509, 4.298255, 4.107784, 4.092998, 3.714676, 3.576368, 3.487228, 3.279868, 2.778431, 2.505196, 2.528652, 2.362056, 2.20155, 2.086764, 2.097655, 2.09721, 2.04518},
 	// precision 12
-	{2953, 2900.4782, 2848.3568, 2796.3666, 2745.324, 2694.9598, 2644.648, 2595.539, 2546.1474, 2498.2576, 2450.8376, 2403.6076, 2357.451, 2311.38, 2266.4104, 2221.5638, 2176.9676, 2134.193, 2090.838, 2048.8548, 2007.018, 1966.1742, 1925.4482, 1885.1294, 1846.4776, 1807.4044, 1768.8724, 1731.3732, 1693.4304, 1657.5326, 1621.949, 1586.5532, 1551.7256, 1517.6182, 1483.5186, 1450.4528, 1417.865, 1385.7164, 1352.6828, 1322.6708, 1291.8312, 1260.9036, 1231.476, 1201.8652, 1173.6718, 1145.757, 1119.2072, 1092.2828, 1065.0434, 1038.6264, 1014.3192, 988.5746, 965.0816, 940.1176, 917.9796, 894.5576, 871.1858, 849.9144, 827.1142, 805.0818, 783.9664, 763.9096, 742.0816, 724.3962, 706.3454, 688.018, 667.4214, 650.3106, 633.0686, 613.8094, 597.818, 581.4248, 563.834, 547.363, 531.5066, 520.455400000001, 505.583199999999, 488.366, 476.480799999999, 459.7682, 450.0522, 434.328799999999, 423.952799999999, 408.727000000001, 399.079400000001, 387.252200000001, 373.987999999999, 360.852000000001, 351.6394, 339.642, 330.902400000001, 322.661599999999, 311.662200000001, 301.3254, 291.7484, 279.939200000001, 276.7508, 263.215200000001, 254.811400000001, 245.5494, 242.306399999999, 234.8734, 223.787200000001, 217.7156, 212.0196, 200.793, 195.9748, 189.0702, 182.449199999999, 177.2772, 170.2336, 164.741, 158.613600000001, 155.311, 147.5964, 142.837, 137.3724, 132.0162, 130.0424, 121.9804, 120.451800000001, 114.8968, 111.585999999999, 105.933199999999, 101.705, 98.5141999999996, 95.0488000000005, 89.7880000000005, 91.4750000000004, 83.7764000000006, 80.9698000000008, 72.8574000000008, 73.1615999999995, 67.5838000000003, 62.6263999999992, 63.2638000000006, 66.0977999999996, 52.0843999999997, 58.9956000000002, 47.0912000000008, 46.4956000000002, 48.4383999999991, 47.1082000000006, 43.2392, 37.2759999999998, 40.0283999999992, 35.1864000000005, 35.8595999999998, 32.0998, 28.027, 23.6694000000007, 33.8266000000003, 26.3736000000008, 27.2008000000005, 21.3245999999999, 26.4115999999995, 23.4521999999997, 19.5013999999992, 19.8513999999996, 10.7492000000002, 18.6424000000006, 13.1265999999996, 18.2436000000016, 6.71860000000015, 3.39459999999963, 6.33759999999893, 7.76719999999841, 0.813999999998487, 3.82819999999992, 0.826199999999517, 8.07440000000133, -1.59080000000176, 5.01780000000144, 0.455399999998917, -0.24199999999837, 0.174800000000687, -9.07640000000174, -4.20160000000033, -3.77520000000004, -4.75179999999818, -5.3724000000002, -8.90680000000066, -6.10239999999976, -5.74120000000039, -9.95339999999851, -3.86339999999836, -13.7304000000004, -16.2710000000006, -7.51359999999841, -3.30679999999847, -13.1339999999982, -10.0551999999989, -6.72019999999975, -8.59660000000076, -10.9307999999983, -1.8775999999998, -4.82259999999951, -13.7788, -21.6470000000008, -10.6735999999983, -15.7799999999988},
-
+	{2900.978, 2848.385, 2796.885, 2745.46, 2695.126, 2645.367, 2595.758, 2547.211, 2498.756, 2451.37, 2404.618, 2357.981, 2312.39, 2266.869, 2222.413, 2178.546, 2134.881, 2092.27, 2049.809, 2008.347, 1967.437, 1926.673, 1886.904, 1847.294, 1808.686, 1770.606, 1732.665, 1695.7, 1659.006, 1623.203, 1587.9, 1552.813, 1518.765, 1484.865, 1451.8, 1419.306, 1386.983, 1355.502, 1324.375, 1294.048, 1264.113, 1234.532, 1205.733, 1177.136, 1149.365, 1122.033, 1094.851, 1068.657, 1042.593, 1017.22, 992.187, 967.524, 943.6138, 919.958, 897.1131, 874.6068, 852.0887, 830.4021, 808.9141, 788.0251, 767.637, 747.4708, 727.8883, 708.534, 689.709, 671.3585, 653.2991, 635.7318, 618.5039, 601.7971, 585.3663, 569.2473, 553.601, 538.0658, 523.1245, 508.5421, 494.199, 480.1481, 466.3704, 453.32, 440.5222, 427.7273, 415.3049, 403.0795, 391.4309, 379.8402, 368.6636, 357.6699, 346.9759, 336.6927, 326.5597, 316.6165, 307.0288, 297.594, 288.4504, 279.5924, 270.9813, 262.4096, 254.2445, 246.2102, 238.5649, 231.0064, 223.6719, 216.5523, 209.8328, 203.1625, 196.4688, 190.1611, 183.8694, 177.9611, 172.1073, 166.4014, 160.933, 155.6196, 150.5403, 145.6435, 140.6095, 135.7785, 131.3433, 127.0142, 122.6552, 118.5033, 114.3275, 110.2191, 106.325, 102.6239, 99.0533, 95.61931, 92.32056, 88.89118, 85.79102, 82.66483, 79.21778, 76.4278, 73.60958, 70.92868, 68.26339, 65.85796, 63.13118, 60.96964, 58.60901, 56.30007, 54.16619, 52.31626, 50.62142, 48.72537, 46.74774, 45.23081, 43.73595, 42.00586, 40.58605, 38.95674, 37.35077, 35.62348, 34.38254, 33.21813, 31.83464, 30.71284, 29.29663, 28.21032, 27.16922, 25.83892, 24.96439, 23.81134, 22.96628, 21.91784, 20.8173, 19.80376, 18.72203, 18.0246, 17.34445, 16.25377, 15.44393, 14.56871, 13.95508, 13.4741, 13.03521, 12.39794, 12.03839, 11.50238, 10.69666, 10.19773, 9.398058, 8.577383, 8.102007, 7.511446, 7.109857, 6.728544, 6.351902, 5.764353, 5.144439, 4.803908, 4.818626, 4.422522, 4.097843, 3.723649, 3.71049, 3.568664, 3.388788, 2.976365},
 	// precision 13
-	{5907.5052, 5802.2672, 5697.347, 5593.5794, 5491.2622, 5390.5514, 5290.3376, 5191.6952, 5093.5988, 4997.3552, 4902.5972, 4808.3082, 4715.5646, 4624.109, 4533.8216, 4444.4344, 4356.3802, 4269.2962, 4183.3784, 4098.292, 4014.79, 3932.4574, 3850.6036, 3771.2712, 3691.7708, 3615.099, 3538.1858, 3463.4746, 3388.8496, 3315.6794, 3244.5448, 3173.7516, 3103.3106, 3033.6094, 2966.5642, 2900.794, 2833.7256, 2769.81, 2707.3196, 2644.0778, 2583.9916, 2523.4662, 2464.124, 2406.073, 2347.0362, 2292.1006, 2238.1716, 2182.7514, 2128.4884, 2077.1314, 2025.037, 1975.3756, 1928.933, 1879.311, 1831.0006, 1783.2144, 1738.3096, 1694.5144, 1649.024, 1606.847, 1564.7528, 1525.3168, 1482.5372, 1443.9668, 1406.5074, 1365.867, 1329.2186, 1295.4186, 1257.9716, 1225.339, 1193.2972, 1156.3578, 1125.8686, 1091.187, 1061.4094, 1029.4188, 1000.9126, 972.3272, 944.004199999999, 915.7592, 889.965, 862.834200000001, 840.4254, 812.598399999999, 785.924200000001, 763.050999999999, 741.793799999999, 721.466, 699.040799999999, 677.997200000002, 649.866999999998, 634.911800000002, 609.8694, 591.981599999999, 570.2922, 557.129199999999, 538.3858, 521.872599999999, 502.951400000002, 495.776399999999, 475.171399999999, 459.751, 439.995200000001, 426.708999999999, 413.7016, 402.3868, 387.262599999998, 372.0524, 357.050999999999, 342.5098, 334.849200000001, 322.529399999999, 311.613799999999, 295.848000000002, 289.273000000001, 274.093000000001, 263.329600000001, 251.389599999999, 245.7392, 231.9614, 229.7952, 217.155200000001, 208.9588, 199.016599999999, 190.839199999999, 180.6976, 176.272799999999, 166.976999999999, 162.5252, 151.196400000001, 149.386999999999, 133.981199999998, 130.0586, 130.164000000001, 122.053400000001, 110.7428, 108.1276, 106.232400000001, 100.381600000001, 98.7668000000012, 86.6440000000002, 79.9768000000004, 82.4722000000002, 68.7026000000005, 70.1186000000016, 71.9948000000004, 58.998599999999, 59.0492000000013, 56.9818000000014, 47.5338000000011, 42.9928, 51.1591999999982, 37.2740000000013, 42.7220000000016, 31.3734000000004, 26.8090000000011, 25.8934000000008, 26.5286000000015, 29.5442000000003, 19.3503999999994, 26.0760000000009, 17.9527999999991, 14.8419999999969, 10.4683999999979, 8.65899999999965, 9.86720000000059, 4.34139999999752, -0.907800000000861, -3.32080000000133, -0.936199999996461, -11.9916000000012, -8.87000000000262, -6.33099999999831, -11.3366000000024, -15.9207999999999, -9.34659999999712, -15.5034000000014, -19.2097999999969, -15.357799999998, -28.2235999999975, -30.6898000000001, -19.3271999999997, -25.6083999999973, -24.409599999999, -13.6385999999984, -33.4473999999973, -32.6949999999997, -28.9063999999998, -31.7483999999968, -32.2935999999972, -35.8329999999987, -47.620600000002, -39.0855999999985, -33.1434000000008, -46.1371999999974, -37.5892000000022, -46.8164000000033, -47.3142000000007, -60.2914000000019, -37.7575999999972},
-
+	{5802.281, 5697.627, 5594.644, 5492.376, 5391.26, 5291.314, 5192.598, 5095.444, 4999.019, 4903.818, 4809.765, 4716.935, 4625.632, 4535.118, 4445.818, 4357.664, 4270.688, 4185.416, 4100.768, 4017.288, 3934.989, 3853.913, 3774.335, 3695.508, 3617.898, 3541.483, 3466.121, 3392.26, 3319.22, 3247.309, 3176.49, 3106.728, 3038.485, 2971.032, 2904.624, 2839.298, 2775.039, 2712.177, 2650.048, 2589.034, 2529.12, 2470.177, 2412.5, 2355.722, 2299.89, 2245.072, 2191.173, 2138.423, 2086.605, 2035.624, 1985.763, 1936.789, 1888.926, 1841.741, 1795.542, 1750.212, 1705.932, 1662.718, 1620.071, 1578.249, 1537.359, 1497.141, 1458.066, 1419.87, 1382.431, 1345.483, 1309.516, 1274.492, 1240.257, 1206.644, 1173.737, 1141.457, 1110.214, 1079.509, 1049.624, 1020.316, 991.8329, 963.9564, 936.4422, 909.7607, 883.6828, 858.4968, 833.9837, 809.6348, 785.9339, 762.8596, 740.3823, 718.598, 697.4108, 676.5874, 656.4618, 636.594, 617.5421, 598.9657, 580.8334, 562.84, 545.4985, 528.9557, 512.6619, 496.2968, 481.0157, 465.83, 450.9505, 436.5686, 422.641, 409.0316, 396.2664, 383.1827, 370.6112, 358.4841, 346.7476, 335.1936, 324.4662, 313.7348, 303.2047, 293.2084, 282.9558, 273.4191, 263.9527, 254.7514, 246.4938, 237.7006, 229.6621, 221.451, 213.6531, 206.1834, 199.158, 191.6775, 184.8984, 178.5072, 172.4522, 166.4748, 160.6159, 154.9109, 149.4081, 143.9369, 139.1745, 134.2712, 129.0697, 124.7112, 120.535, 116.3151, 111.7573, 107.2403, 103.4411, 99.57318, 95.21052, 91.77585, 88.46838, 85.38821, 81.98541, 78.82753, 75.82297, 72.65179, 69.50239, 66.85528, 64.50963, 61.95659, 59.3488, 57.0305, 54.98521, 52.77917, 50.54412, 48.59159, 46.52873, 44.59986, 43.02907, 41.58548, 39.45555, 37.80206, 35.80772, 34.40236, 33.40043, 32.07094, 30.83158, 29.9197, 28.67229, 27.40949, 26.1507, 25.37277, 24.64738, 23.58965, 22.23835, 21.42207, 20.87469, 20.32465, 19.45418, 19.01983, 18.13497, 17.46005, 16.67102, 15.75254, 14.98715, 14.11701, 13.75961, 13.04683, 12.37017, 11.45388, 11.08387, 10.99262},
 	// precision 14
-	{11816.475, 11605.0046, 11395.3792, 11188.7504, 10984.1814, 10782.0086, 10582.0072, 10384.503, 10189.178, 9996.2738, 9806.0344, 9617.9798, 9431.394, 9248.7784, 9067.6894, 8889.6824, 8712.9134, 8538.8624, 8368.4944, 8197.7956, 8031.8916, 7866.6316, 7703.733, 7544.5726, 7386.204, 7230.666, 7077.8516, 6926.7886, 6778.6902, 6631.9632, 6487.304, 6346.7486, 6206.4408, 6070.202, 5935.2576, 5799.924, 5671.0324, 5541.9788, 5414.6112, 5290.0274, 5166.723, 5047.6906, 4929.162, 4815.1406, 4699.127, 4588.5606, 4477.7394, 4369.4014, 4264.2728, 4155.9224, 4055.581, 3955.505, 3856.9618, 3761.3828, 3666.9702, 3575.7764, 3482.4132, 3395.0186, 3305.8852, 3221.415, 3138.6024, 3056.296, 2970.4494, 2896.1526, 2816.8008, 2740.2156, 2670.497, 2594.1458, 2527.111, 2460.8168, 2387.5114, 2322.9498, 2260.6752, 2194.2686, 2133.7792, 2074.767, 2015.204, 1959.4226, 1898.6502, 1850.006, 1792.849, 1741.4838, 1687.9778, 1638.1322, 1589.3266, 1543.1394, 1496.8266, 1447.8516, 1402.7354, 1361.9606, 1327.0692, 1285.4106, 1241.8112, 1201.6726, 1161.973, 1130.261, 1094.2036, 1048.2036, 1020.6436, 990.901400000002, 961.199800000002, 924.769800000002, 899.526400000002, 872.346400000002, 834.375, 810.432000000001, 780.659800000001, 756.013800000001, 733.479399999997, 707.923999999999, 673.858, 652.222399999999, 636.572399999997, 615.738599999997, 586.696400000001, 564.147199999999, 541.679600000003, 523.943599999999, 505.714599999999, 475.729599999999, 461.779600000002, 449.750800000002, 439.020799999998, 412.7886, 400.245600000002, 383.188199999997, 362.079599999997, 357.533799999997, 334.319000000003, 327.553399999997, 308.559399999998, 291.270199999999, 279.351999999999, 271.791400000002, 252.576999999997, 247.482400000001, 236.174800000001, 218.774599999997, 220.155200000001, 208.794399999999, 201.223599999998, 182.995600000002, 185.5268, 164.547400000003, 176.5962, 150.689599999998, 157.8004, 138.378799999999, 134.021200000003, 117.614399999999, 108.194000000003, 97.0696000000025, 89.6042000000016, 95.6030000000028, 84.7810000000027, 72.635000000002, 77.3482000000004, 59.4907999999996, 55.5875999999989, 50.7346000000034, 61.3916000000027, 50.9149999999936, 39.0384000000049, 58.9395999999979, 29.633600000001, 28.2032000000036, 26.0078000000067, 17.0387999999948, 9.22000000000116, 13.8387999999977, 8.07240000000456, 14.1549999999988, 15.3570000000036, 3.42660000000615, 6.24820000000182, -2.96940000000177, -8.79940000000352, -5.97860000000219, -14.4048000000039, -3.4143999999942, -13.0148000000045, -11.6977999999945, -25.7878000000055, -22.3185999999987, -24.409599999999, -31.9756000000052, -18.9722000000038, -22.8678000000073, -30.8972000000067, -32.3715999999986, -22.3907999999938, -43.6720000000059, -35.9038, -39.7492000000057, -54.1641999999993, -45.2749999999942, -42.2989999999991, -44.1089999999967, -64.3564000000042, -49.9551999999967, -42.6116000000038},
-
+	{11605.33, 11396.54, 11189.55, 10985.43, 10783.16, 10583.22, 10386.13, 10190.88, 9998.533, 9808.142, 9620.026, 9434.746, 9251.453, 9070.872, 8892.353, 8716.034, 8542.513, 8370.979, 8202.151, 8035.336, 7870.71, 7708.939, 7549.004, 7391.643, 7236.193, 7083.189, 6932.819, 6784.419, 6638.608, 6494.726, 6353.414, 6214.359, 6077.179, 5942.502, 5809.51, 5678.809, 5550.627, 5424.238, 5300.246, 5178.327, 5058.631, 4940.832, 4825.086, 4711.884, 4600.288, 4490.398, 4382.79, 4276.843, 4173.321, 4071.699, 3971.551, 3873.638, 3777.513, 3683.57, 3590.758, 3500.247, 3411.703, 3324.807, 3239.893, 3156.761, 3074.771, 2994.721, 2916.189, 2839.795, 2764.797, 2691.353, 2619.264, 2549.279, 2480.315, 2412.621, 2346.728, 2282.342, 2219.296, 2157.816, 2097.919, 2039.468, 1982.377, 1926.367, 1871.551, 1818.282, 1766.239, 1715.536, 1665.828, 1617.437, 1570.369, 1523.857, 1478.446, 1435.241, 1392.616, 1350.694, 1310.116, 1270.678, 1231.992, 1194.82, 1157.749, 1122.227, 1086.884, 1053.464, 1020.548, 988.9321, 958.1843, 928.2553, 899.0268, 870.4586, 843.1065, 816.2784, 790.5401, 764.9115, 740.0055, 716.7353, 693.5083, 671.2611, 649.604, 628.412, 607.7914, 587.3145, 568.0263, 549.523, 531.6574, 514.1774, 497.1078, 480.1778, 463.3565, 447.805, 432.5883, 417.5697, 403.6226, 389.7771, 375.5269, 362.0429, 349.7675, 337.3223, 325.5925, 313.8448, 302.619, 291.6978, 280.8011, 270.7111, 261.5239, 252.9115, 244.3706, 235.4539, 226.7158, 218.7995, 209.9802, 202.6519, 195.3184, 187.3569, 180.224, 173.8244, 167.2737, 160.3527, 153.696, 148.292, 143.6223, 138.4634, 133.8559, 129.4127, 124.2595, 120.1944, 115.6582, 111.9919, 107.8406, 103.9332, 99.8315, 96.38156, 92.60036, 89.22843, 86.22024, 83.37276, 79.24349, 76.44954, 73.04927, 70.24381, 67.42944, 65.62438, 63.10344, 60.81586, 58.89082, 56.87216, 54.03833, 51.97998, 49.47418, 48.33371, 45.54135, 43.44786, 41.17608, 38.67762, 37.38796, 35.68611, 33.86046, 32.02113, 30.85091, 30.03876, 27.53918, 26.37862, 25.45508, 24.40714, 23.07208, 22.51785},
 	// precision 15
-	{23634.0036, 23210.8034, 22792.4744, 22379.1524, 21969.7928, 21565.326, 21165.3532, 20770.2806, 20379.9892, 19994.7098, 19613.318, 19236.799, 18865.4382, 18498.8244, 18136.5138, 17778.8668, 17426.2344, 17079.32, 16734.778, 16397.2418, 16063.3324, 15734.0232, 15409.731, 15088.728, 14772.9896, 14464.1402, 14157.5588, 13855.5958, 13559.3296, 13264.9096, 12978.326, 12692.0826, 12413.8816, 12137.3192, 11870.2326, 11602.5554, 11340.3142, 11079.613, 10829.5908, 10583.5466, 10334.0344, 10095.5072, 9859.694, 9625.2822, 9395.7862, 9174.0586, 8957.3164, 8738.064, 8524.155, 8313.7396, 8116.9168, 7913.542, 7718.4778, 7521.65, 7335.5596, 7154.2906, 6968.7396, 6786.3996, 6613.236, 6437.406, 6270.6598, 6107.7958, 5945.7174, 5787.6784, 5635.5784, 5482.308, 5337.9784, 5190.0864, 5045.9158, 4919.1386, 4771.817, 4645.7742, 4518.4774, 4385.5454, 4262.6622, 4142.74679999999, 4015.5318, 3897.9276, 3790.7764, 3685.13800000001, 3573.6274, 3467.9706, 3368.61079999999, 3271.5202, 3170.3848, 3076.4656, 2982.38400000001, 2888.4664, 2806.4868, 2711.9564, 2634.1434, 2551.3204, 2469.7662, 2396.61139999999, 2318.9902, 2243.8658, 2171.9246, 2105.01360000001, 2028.8536, 1960.9952, 1901.4096, 1841.86079999999, 1777.54700000001, 1714.5802, 1654.65059999999, 1596.311, 1546.2016, 1492.3296, 1433.8974, 1383.84600000001, 1339.4152, 1293.5518, 1245.8686, 1193.50659999999, 1162.27959999999, 1107.19439999999, 1069.18060000001, 1035.09179999999, 999.679000000004, 957.679999999993, 925.300199999998, 888.099400000006, 848.638600000006, 818.156400000007, 796.748399999997, 752.139200000005, 725.271200000003, 692.216, 671.633600000001, 647.939799999993, 621.670599999998, 575.398799999995, 561.226599999995, 532.237999999998, 521.787599999996, 483.095799999996, 467.049599999998, 465.286399999997, 415.548599999995, 401.047399999996, 380.607999999993, 377.362599999993, 347.258799999996, 338.371599999999, 310.096999999994, 301.409199999995, 276.280799999993, 265.586800000005, 258.994399999996, 223.915999999997, 215.925399999993, 213.503800000006, 191.045400000003, 166.718200000003, 166.259000000005, 162.941200000001, 148.829400000002, 141.645999999993, 123.535399999993, 122.329800000007, 89.473399999988, 80.1962000000058, 77.5457999999926, 59.1056000000099, 83.3509999999951, 52.2906000000075, 36.3979999999865, 40.6558000000077, 42.0003999999899, 19.6630000000005, 19.7153999999864, -8.38539999999921, -0.692799999989802, 0.854800000000978, 3.23219999999856, -3.89040000000386, -5.25880000001052, -24.9052000000083, -22.6837999999989, -26.4286000000138, -34.997000000003, -37.0216000000073, -43.430400000012, -58.2390000000014, -68.8034000000043, -56.9245999999985, -57.8583999999973, -77.3097999999882, -73.2793999999994, -81.0738000000129, -87.4530000000086, -65.0254000000132, -57.296399999992, -96.2746000000043, -103.25, -96.081600000005, -91.5542000000132, -102.465200000006, -107.688599999994, -101.458000000013, -109.715800000005},
-
+	{23211.94, 22793.77, 22379.84, 21971.09, 21567.11, 21167.9, 20773.22, 20382.74, 19997.72, 19617.33, 19241.49, 18870.45, 18503.7, 18142.37, 17785.81, 17433.71, 17086.26, 16743.22, 16405.21, 16072.01, 15743.31, 15419.26, 15099.61, 14785.03, 14474.78, 14169.04, 13868.13, 13571.56, 13279.74, 12992.23, 12709.43, 12431.48, 12157.24, 11887.33, 11621.97, 11361.56, 11104.89, 10852.38, 10604.06, 10360.8, 10120.94, 9885.061, 9653.48, 9426.016, 9202.871, 8983.809, 8768.599, 8556.9, 8349.615, 8146.036, 7945.937, 7750.021, 7558.091, 7369.319, 7184.835, 7002.809, 6825.424, 6651.311, 6481.155, 6313.824, 6149.872, 5989.796, 5833.765, 5679.501, 5529.242, 5382.527, 5239, 5098.511, 4960.469, 4825.441, 4693.099, 4564.868, 4439.159, 4315.046, 4195.109, 4077.271, 3962.63, 3849.867, 3741.907, 3634.214, 3530.127, 3428.702, 3329.543, 3232.878, 3138.252, 3046.804, 2957.255, 2869.593, 2783.51, 2701.105, 2620.539, 2541.454, 2464.173, 2388.163, 2314.951, 2243.706, 2174.074, 2106.631, 2041.082, 1976.663, 1914.59, 1853.802, 1796.177, 1738.007, 1682.944, 1627.344, 1575.054, 1524.275, 1475.154, 1427.578, 1380.782, 1334.448, 1289.656, 1245.637, 1204.56, 1163.601, 1123.267, 1085.292, 1048.931, 1013.279, 978.8886, 945.2097, 913.2586, 881.7409, 850.5625, 819.9739, 793.3674, 765.8582, 739.5687, 713.5627, 688.9922, 664.718, 643.0816, 621.1017, 598.1433, 576.2792, 556.5601, 535.6364, 515.9213, 496.7775, 478.1318, 460.44, 443.3885, 427.1341, 410.3977, 395.1417, 379.878, 365.036, 351.0374, 337.1945, 323.7613, 311.0869, 298.5058, 286.3732, 275.7445, 264.9304, 254.2054, 245.1492, 234.0806, 224.6514, 215.1317, 208.0446, 199.5714, 192.6643, 183.2839, 177.7844, 170.2842, 164.5729, 156.4281, 150.6065, 145.8715, 138.0404, 132.4775, 127.4248, 121.8142, 115.7267, 110.9199, 105.9079, 101.4298, 93.83355, 89.43599, 86.61895, 83.28053, 81.43714, 79.7209, 76.42134, 71.57019, 69.22809, 67.19095, 62.36267, 58.75922, 56.13807, 52.71219, 51.54128, 49.02879, 45.41135, 44.22085, 40.46147, 39.06485, 36.99587},
 	// precision 16
-	{47270, 46423.3584, 45585.7074, 44757.152, 43938.8416, 43130.9514, 42330.03, 41540.407, 40759.6348, 39988.206, 39226.5144, 38473.2096, 37729.795, 36997.268, 36272.6448, 35558.665, 34853.0248, 34157.4472, 33470.5204, 32793.5742, 32127.0194, 31469.4182, 30817.6136, 30178.6968, 29546.8908, 28922.8544, 28312.271, 27707.0924, 27114.0326, 26526.692, 25948.6336, 25383.7826, 24823.5998, 24272.2974, 23732.2572, 23201.4976, 22674.2796, 22163.6336, 21656.515, 21161.7362, 20669.9368, 20189.4424, 19717.3358, 19256.3744, 18795.9638, 18352.197, 17908.5738, 17474.391, 17052.918, 16637.2236, 16228.4602, 15823.3474, 15428.6974, 15043.0284, 14667.6278, 14297.4588, 13935.2882, 13578.5402, 13234.6032, 12882.1578, 12548.0728, 12219.231, 11898.0072, 11587.2626, 11279.9072, 10973.5048, 10678.5186, 10392.4876, 10105.2556, 9825.766, 9562.5444, 9294.2222, 9038.2352, 8784.848, 8533.2644, 8301.7776, 8058.30859999999, 7822.94579999999, 7599.11319999999, 7366.90779999999, 7161.217, 6957.53080000001, 6736.212, 6548.21220000001, 6343.06839999999, 6156.28719999999, 5975.15419999999, 5791.75719999999, 5621.32019999999, 5451.66, 5287.61040000001, 5118.09479999999, 4957.288, 4798.4246, 4662.17559999999, 4512.05900000001, 4364.68539999999, 4220.77720000001, 4082.67259999999, 3957.19519999999, 3842.15779999999, 3699.3328, 3583.01180000001, 3473.8964, 3338.66639999999, 3233.55559999999, 3117.799, 3008.111, 2909.69140000001, 2814.86499999999, 2719.46119999999, 2624.742, 2532.46979999999, 2444.7886, 2370.1868, 2272.45259999999, 2196.19260000001, 2117.90419999999, 2023.2972, 1969.76819999999, 1885.58979999999, 1833.2824, 1733.91200000001, 1682.54920000001, 1604.57980000001, 1556.11240000001, 1491.3064, 1421.71960000001, 1371.22899999999, 1322.1324, 1264.7892, 1196.23920000001, 1143.8474, 1088.67240000001, 1073.60380000001, 1023.11660000001, 959.036400000012, 927.433199999999, 906.792799999996, 853.433599999989, 841.873800000001, 791.1054, 756.899999999994, 704.343200000003, 672.495599999995, 622.790399999998, 611.254799999995, 567.283200000005, 519.406599999988, 519.188400000014, 495.312800000014, 451.350799999986, 443.973399999988, 431.882199999993, 392.027000000002, 380.924200000009, 345.128999999986, 298.901400000002, 287.771999999997, 272.625, 247.253000000026, 222.490600000019, 223.590000000026, 196.407599999977, 176.425999999978, 134.725199999986, 132.4804, 110.445599999977, 86.7939999999944, 56.7038000000175, 64.915399999998, 38.3726000000024, 37.1606000000029, 46.170999999973, 49.1716000000015, 15.3362000000197, 6.71639999997569, -34.8185999999987, -39.4476000000141, 12.6830000000191, -12.3331999999937, -50.6565999999875, -59.9538000000175, -65.1054000000004, -70.7576000000117, -106.325200000021, -126.852200000023, -110.227599999984, -132.885999999999, -113.897200000007, -142.713800000027, -151.145399999979, -150.799200000009, -177.756200000003, -156.036399999983, -182.735199999996, -177.259399999981, -198.663600000029, -174.577600000019, -193.84580000001},
-
+	{46424.79, 45587.97, 44760.98, 43943.17, 43135.14, 42336.43, 41546.84, 40767.08, 39996.51, 39235.76, 38484.55, 37742.22, 37010.32, 36286.56, 35573.12, 34868.89, 34173.66, 33488.1, 32811.99, 32145.31, 31487.8, 30839.03, 30200.64, 29570.32, 28950.17, 28338.8, 27736.19, 27143.58, 26558.63, 25983.69, 25417.35, 24859.56, 24311.83, 23771.25, 23240.38, 22718.51, 22204.75, 21699.91, 21203.17, 20715.88, 20235.91, 19764.26, 19301.44, 18845.76, 18398.46, 17959.6, 17529.29, 17105.95, 16690.86, 16284.77, 15885.91, 15494.27, 15109.64, 14732.17, 14362.89, 13999.86, 13645.45, 13299.19, 12958.46, 12624.38, 12298.35, 11976.66, 11663.2, 11356.11, 11056.99, 10761.8, 10473.05, 10191.39, 9915.264, 9645.755, 9381.451, 9124.267, 8872.01, 8626.354, 8385.867, 8151.436, 7920.107, 7697.325, 7479.8, 7267.547, 7059.04, 6855.16, 6656.657, 6465.049, 6277.152, 6095.228, 5915.738, 5741.932, 5571.238, 5405.942, 5243.963, 5085.085, 4931.522, 4779.289, 4631.539, 4488.414, 4352.73, 4218.443, 4086.972, 3959.065, 3833.447, 3710.464, 3593.271, 3481.387, 3368.793, 3260.322, 3155.289, 3055.873, 2958.854, 2864.456, 2771.828, 2682.243, 2592.605, 2508.565, 2424.472, 2342.836, 2264.412, 2189.573, 2114.97, 2041.143, 1971.131, 1903.636, 1837.42, 1771.905, 1708.837, 1652.163, 1596.974, 1542.34, 1489.135, 1439.259, 1387.363, 1339.265, 1293.252, 1247.3, 1202.379, 1156.047, 1115.806, 1077.296, 1039.777, 1000.818, 963.2591, 925.2724, 893.7646, 862.0665, 827.7982, 799.8904, 766.4389, 735.2877, 709.3855, 681.8694, 655.1063, 631.5484, 605.5805, 583.1735, 561.1262, 539.9608, 523.0527, 503.8595, 484.1332, 464.7284, 447.3959, 427.774, 416.3957, 399.7956, 386.2785, 374.1225, 359.8567, 348.4417, 330.5114, 321.8376, 309.9845, 296.9874, 283.6955, 270.7439, 261.4029, 252.3871, 241.9323, 231.9043, 221.6884, 214.5305, 207.4476, 199.3096, 187.0173, 173.8261, 168.4299, 162.2705, 153.7763, 145.685, 137.3969, 132.6321, 127.199, 121.5107, 115.1765, 113.4063, 110.5279, 109.3883, 104.8611, 96.53372, 92.40245, 86.10017},
 	// precision 17
-	{94541, 92848.811, 91174.019, 89517.558, 87879.9705, 86262.7565, 84663.5125, 83083.7435, 81521.7865, 79977.272, 78455.9465, 76950.219, 75465.432, 73994.152, 72546.71, 71115.2345, 69705.6765, 68314.937, 66944.2705, 65591.255, 64252.9485, 62938.016, 61636.8225, 60355.592, 59092.789, 57850.568, 56624.518, 55417.343, 54231.1415, 53067.387, 51903.526, 50774.649, 49657.6415, 48561.05, 47475.7575, 46410.159, 45364.852, 44327.053, 43318.4005, 42325.6165, 41348.4595, 40383.6265, 39436.77, 38509.502, 37594.035, 36695.939, 35818.6895, 34955.691, 34115.8095, 33293.949, 32465.0775, 31657.6715, 30877.2585, 30093.78, 29351.3695, 28594.1365, 27872.115, 27168.7465, 26477.076, 25774.541, 25106.5375, 24452.5135, 23815.5125, 23174.0655, 22555.2685, 21960.2065, 21376.3555, 20785.1925, 20211.517, 19657.0725, 19141.6865, 18579.737, 18081.3955, 17578.995, 17073.44, 16608.335, 16119.911, 15651.266, 15194.583, 14749.0495, 14343.4835, 13925.639, 13504.509, 13099.3885, 12691.2855, 12328.018, 11969.0345, 11596.5145, 11245.6355, 10917.6575, 10580.9785, 10277.8605, 9926.58100000001, 9605.538, 9300.42950000003, 8989.97850000003, 8728.73249999998, 8448.3235, 8175.31050000002, 7898.98700000002, 7629.79100000003, 7413.76199999999, 7149.92300000001, 6921.12650000001, 6677.1545, 6443.28000000003, 6278.23450000002, 6014.20049999998, 5791.20299999998, 5605.78450000001, 5438.48800000001, 5234.2255, 5059.6825, 4887.43349999998, 4682.935, 4496.31099999999, 4322.52250000002, 4191.42499999999, 4021.24200000003, 3900.64799999999, 3762.84250000003, 3609.98050000001, 3502.29599999997, 3363.84250000003, 3206.54849999998, 3079.70000000001, 2971.42300000001, 2867.80349999998, 2727.08100000001, 2630.74900000001, 2496.6165, 2440.902, 2356.19150000002, 2235.58199999999, 2120.54149999999, 2012.25449999998, 1933.35600000003, 1820.93099999998, 1761.54800000001, 1663.09350000002, 1578.84600000002, 1509.48149999999, 1427.3345, 1379.56150000001, 1306.68099999998, 1212.63449999999, 1084.17300000001, 1124.16450000001, 1060.69949999999, 1007.48849999998, 941.194499999983, 879.880500000028, 836.007500000007, 782.802000000025, 748.385499999975, 647.991500000004, 626.730500000005, 570.776000000013, 484.000500000024, 513.98550000001, 418.985499999952, 386.996999999974, 370.026500000036, 355.496999999974, 356.731499999994, 255.92200000002, 259.094000000041, 205.434499999974, 165.374500000034, 197.347500000033, 95.718499999959, 67.6165000000037, 54.6970000000438, 31.7395000000251, -15.8784999999916, 8.42500000004657, -26.3754999999655, -118.425500000012, -66.6629999999423, -42.9745000000112, -107.364999999991, -189.839000000036, -162.611499999999, -164.964999999967, -189.079999999958, -223.931499999948, -235.329999999958, -269.639500000048, -249.087999999989, -206.475499999942, -283.04449999996, -290.667000000016, -304.561499999953, -336.784499999951, -380.386500000022, -283.280499999993, -364.533000000054, -389.059499999974, -364.454000000027, -415.748000000021, -417.155000000028},
-
+	{92850.04, 91176.99, 89523.11, 87887.97, 86271.78, 84673.57, 83095.09, 81535.63, 79995.01, 78473.18, 76971.01, 75487.23, 74022.07, 72575.53, 71148.8, 69739.95, 68349.54, 66979.52, 65626.84, 64293.86, 62979.38, 61682.65, 60405.8, 59145.19, 57904.68, 56682.48, 55478.43, 54291.8, 53123.12, 51973.41, 50840.1, 49725.83, 48627.52, 47549.4, 46487.16, 45442.91, 44415.85, 43407.53, 42415.52, 41439.33, 40481.19, 39539.63, 38613.84, 37704.61, 36811.2, 35934.62, 35073.7, 34227.47, 33395.72, 32583.41, 31785.27, 31002.26, 30234.09, 29486.72, 28747.43, 28024.14, 27312.27, 26616.9, 25935.5, 25268.71, 24612.05, 23972.95, 23347.3, 22732.11, 22130.48, 21544.18, 20968.55, 20406.82, 19858.43, 19321.75, 18796.57, 18283.21, 17782.43, 17291.69, 16810.3, 16337.65, 15877.72, 15432.39, 14992.41, 14564.76, 14148.19, 13741.97, 13347.6, 12962.36, 12585.5, 12215.67, 11855.79, 11502.03, 11158.61, 10826.54, 10503.78, 10187.1, 9874.457, 9574.146, 9282.808, 8999.707, 8724.261, 8455.138, 8192.439, 7936.187, 7683.57, 7444.421, 7208.188, 6977.152, 6754.173, 6536.103, 6330.497, 6133.094, 5932.612, 5739.36, 5552.795, 5369.228, 5199.571, 5027.484, 4858.438, 4700.469, 4542.521, 4396.291, 4248.587, 4104.548, 3968.985, 3836.95, 3707.848, 3585.22, 3463.729, 3348.59, 3229.652, 3118.911, 3008.976, 2905.204, 2802.901, 2703.107, 2605.351, 2518.054, 2428.421, 2342.035, 2257.126, 2176.369, 2098.439, 2024.931, 1950.172, 1886.466, 1817.175, 1753.412, 1689.325, 1630.856, 1565.532, 1512.143, 1450.839, 1394.823, 1341.873, 1288.776, 1250.558, 1207.531, 1161.292, 1115.984, 1068.07, 1035.24, 995.2639, 957.2934, 922.5651, 887.3452, 852.3792, 823.1345, 793.9675, 758.4995, 727.3504, 699.2432, 667.7951, 639.8952, 620.4624, 594.6942, 574.1223, 549.6056, 522.08, 503.9104, 486.0599, 463.3593, 446.2918, 432.0779, 410.1561, 398.6367, 389.4414, 375.9723, 360.6079, 349.1735, 333.4355, 326.0283, 308.1039, 301.1156, 285.0311, 267.9162, 248.8795, 239.4557, 221.1305, 213.2094, 207.0961, 200.4855, 194.7589, 189.0262},
 	// precision 18
-	{189083, 185696.913, 182348.774, 179035.946, 175762.762, 172526.444, 169329.754, 166166.099, 163043.269, 159958.91, 156907.912, 153906.845, 150924.199, 147996.568, 145093.457, 142239.233, 139421.475, 136632.27, 133889.588, 131174.2, 128511.619, 125868.621, 123265.385, 120721.061, 118181.769, 115709.456, 113252.446, 110840.198, 108465.099, 106126.164, 103823.469, 101556.618, 99308.004, 97124.508, 94937.803, 92833.731, 90745.061, 88677.627, 86617.47, 84650.442, 82697.833, 80769.132, 78879.629, 77014.432, 75215.626, 73384.587, 71652.482, 69895.93, 68209.301, 66553.669, 64921.981, 63310.323, 61742.115, 60205.018, 58698.658, 57190.657, 55760.865, 54331.169, 52908.167, 51550.273, 50225.254, 48922.421, 47614.533, 46362.049, 45098.569, 43926.083, 42736.03, 41593.473, 40425.26, 39316.237, 38243.651, 37170.617, 36114.609, 35084.19, 34117.233, 33206.509, 32231.505, 31318.728, 30403.404, 29540.0550000001, 28679.236, 27825.862, 26965.216, 26179.148, 25462.08, 24645.952, 23922.523, 23198.144, 22529.128, 21762.4179999999, 21134.779, 20459.117, 19840.818, 19187.04, 18636.3689999999, 17982.831, 17439.7389999999, 16874.547, 16358.2169999999, 15835.684, 15352.914, 14823.681, 14329.313, 13816.897, 13342.874, 12880.882, 12491.648, 12021.254, 11625.392, 11293.7610000001, 10813.697, 10456.209, 10099.074, 9755.39000000001, 9393.18500000006, 9047.57900000003, 8657.98499999999, 8395.85900000005, 8033, 7736.95900000003, 7430.59699999995, 7258.47699999996, 6924.58200000005, 6691.29399999999, 6357.92500000005, 6202.05700000003, 5921.19700000004, 5628.28399999999, 5404.96799999999, 5226.71100000001, 4990.75600000005, 4799.77399999998, 4622.93099999998, 4472.478, 4171.78700000001, 3957.46299999999, 3868.95200000005, 3691.14300000004, 3474.63100000005, 3341.67200000002, 3109.14000000001, 3071.97400000005, 2796.40399999998, 2756.17799999996, 2611.46999999997, 2471.93000000005, 2382.26399999997, 2209.22400000005, 2142.28399999999, 2013.96100000001, 1911.18999999994, 1818.27099999995, 1668.47900000005, 1519.65800000005, 1469.67599999998, 1367.13800000004, 1248.52899999998, 1181.23600000003, 1022.71900000004, 1088.20700000005, 959.03600000008, 876.095999999903, 791.183999999892, 703.337000000058, 731.949999999953, 586.86400000006, 526.024999999907, 323.004999999888, 320.448000000091, 340.672999999952, 309.638999999966, 216.601999999955, 102.922999999952, 19.2399999999907, -0.114000000059605, -32.6240000000689, -89.3179999999702, -153.497999999905, -64.2970000000205, -143.695999999996, -259.497999999905, -253.017999999924, -213.948000000091, -397.590000000084, -434.006000000052, -403.475000000093, -297.958000000101, -404.317000000039, -528.898999999976, -506.621000000043, -513.205000000075, -479.351000000024, -596.139999999898, -527.016999999993, -664.681000000099, -680.306000000099, -704.050000000047, -850.486000000034, -757.43200000003, -713.308999999892},
+	{185699.5, 182353.4, 179044.8, 175774.6, 172541.7, 169346.1, 166188.9, 163068.5, 159986.5, 156942.7, 153938.5, 150971.4, 148040.2, 145149.7, 142294.2, 139476.6, 136697.1, 133953.9, 131250, 128583.3, 125956.8, 123365, 120809.3, 118293.3, 115812.5, 113368.6, 110960.4, 108590.2, 106257.5, 103959.5, 101700, 99471.06, 97275.18, 95117.25, 92992.14, 90906.17, 88853.08, 86833.98, 84848.2, 82896.33, 80978.28, 79093.69, 77240.58, 75424.04, 73637.8, 71886.55, 70163.72, 68474.5, 66813.31, 65185.18, 63582.65, 62017.05, 60480.43, 58976.49, 57496.46, 56047.3, 54632.33, 53241.44, 51869.02, 50534.41, 49231.36, 47949.51, 46688.73, 45461.73, 44260.57, 43087.11, 41946.84, 40822.42, 39724.65, 38648.02, 37596.75, 36569, 35559.5, 34580.15, 33626.62, 32691.18, 31776.6, 30882.42, 30006.01, 29160.34, 28319.44, 27501.41, 26713.8, 25941.89, 25183.08, 24443.33, 23719.91, 23032.37, 22354.29, 21686.52, 21036.82, 20400.27, 19789.2, 19191.19, 18609.32, 18047.67, 17501.44, 16972.63, 16445.62, 15942.81, 15452.94, 14965.11, 14490.07, 14035.09, 13589.89, 13156.3, 12733.02, 12318.63, 11929.85, 11551.48, 11183.08, 10815.91, 10464.58, 10129.25, 9788.652, 9464.455, 9156.099, 8859.059, 8570.552, 8281.909, 8005.825, 7750.201, 7483.124, 7231.364, 6995.627, 6754.173, 6525.13, 6300.836, 6081.979, 5880.873, 5681.741, 5483.254, 5281.555, 5093.353, 4918.368, 4744.519, 4581.085, 4418.093, 4252.495, 4104.852, 3942.223, 3796.058, 3663.949, 3537.829, 3414.567, 3293.015, 3168.173, 3061.623, 2955.276, 2848.618, 2743.09, 2642.152, 2543.072, 2451.86, 2361.34, 2279.865, 2177.438, 2101.765, 2025.851, 1948.259, 1869.538, 1793.469, 1729.474, 1664.738, 1606.632, 1525.949, 1459.261, 1386.145, 1324.901, 1270.276, 1235.786, 1178.397, 1143.635, 1111.031, 1065.079, 1025.054, 992.6837, 951.4936, 895.5735, 853.8128, 812.4636, 771.2754, 741.199, 722.0368, 683.7395, 655.3162, 624.1147, 583.0072, 543.8422, 525.693, 488.0945, 463.8716, 466.7201, 440.8016, 406.1209, 388.035, 367.3093, 356.4116, 328.3211, 312.4382},
+	// precision 19
+	{371402.3, 364710.7, 358093.7, 351552.5, 345085.9, 338695.9, 332382.5, 326144.8, 319981.3, 313894.8, 307882.8, 301947.2, 296084.7, 290299.6, 284591.6, 278956.4, 273397.6, 267914.2, 262503.5, 257167.9, 251905.9, 246723.9, 241608.6, 236572.8, 231609.1, 226714.7, 221898.8, 217153.2, 212483.4, 207884.4, 203351.1, 198895.9, 194500.6, 190172.4, 185927, 181749.1, 177639.8, 173591.8, 169620.5, 165716.7, 161877.9, 158110.5, 154401.2, 150764.4, 147192.3, 143688.1, 140241.3, 136857.8, 133535.5, 130286, 127087.5, 123962.7, 120889.2, 117877.6, 114926.9, 112021.9, 109185, 106407.3, 103682.9, 101003.1, 98395.72, 95827.18, 93312.75, 90847.54, 88437.4, 86088.37, 83784.99, 81535.29, 79333.74, 77171.36, 75069.13, 73021.5, 71013.52, 69054.85, 67132.55, 65242.09, 63410.11, 61620.18, 59882.25, 58163.8, 56500.95, 54876.31, 53280.38, 51731.58, 50230.01, 48761.73, 47325.23, 45930.06, 44566.31, 43221.81, 41928.71, 40678.52, 39457.25, 38272.96, 37101.74, 35975.73, 34879.11, 33806.39, 32751.7, 31730.83, 30754.69, 29797.09, 28860.69, 27950.11, 27061.14, 26205.74, 25356.54, 24551.79, 23764.65, 23012.41, 22269.78, 21560.38, 20869.59, 20189.44, 19499.43, 18850.73, 18221.02, 17615.2, 17028.62, 16465.12, 15904.42, 15375.39, 14839.06, 14326.44, 13862.85, 13375.11, 12889.58, 12451.85, 12027.72, 11595.36, 11200.8, 10805.25, 10430.18, 10053.05, 9706.45, 9383.474, 9031.45, 8693.305, 8372.047, 8094.007, 7801.707, 7492.816, 7186.822, 6932.445, 6669.354, 6435.861, 6190.878, 5974.041, 5786.854, 5577.039, 5355.579, 5163.136, 4956.912, 4730.437, 4550.531, 4366.344, 4203.525, 4031.651, 3856.089, 3719.971, 3581.722, 3443.774, 3307.104, 3161.944, 3056.787, 2940.441, 2823.805, 2689.58, 2564.723, 2473.358, 2353.061, 2218.297, 2141.094, 2087.432, 2005.373, 1921.738, 1853.928, 1768.125, 1692.017, 1629.643, 1548.819, 1478.911, 1419.062, 1352.016, 1271.532, 1198.374, 1137.973, 1109.132, 1061.98, 1030.197, 984.544, 906.8947, 836.4873, 797.1233, 739.5805, 703.2136, 673.4018, 653.5762, 618.7883, 568.301},
+	// precision 20
+	{742802.7, 729420, 716187.6, 703103.5, 690173.6, 677395.6, 664765.2, 652290, 639965.3, 627793.9, 615768.6, 603891.1, 592168.7, 580592.1, 569173.4, 557903.2, 546782, 535816.8, 524997.5, 514333, 503813.7, 493449.3, 483225.4, 473144.7, 463218.5, 453441.9, 443805.4, 434312.9, 424968.7, 415768.1, 406710, 397799, 389015.3, 380375, 371882.4, 363529.2, 355315.4, 347230.3, 339296.3, 331485.4, 323812.5, 316274.1, 308859.7, 301579.5, 294424.6, 287390.3, 280500.1, 273740.2, 267109.8, 260593.3, 254216.3, 247936.1, 241784.1, 235757.8, 229831.2, 224035.4, 218346.7, 212793.4, 207329.2, 201987.1, 196758.3, 191642.6, 186634.3, 181730.8, 176939.7, 172220, 167608.6, 163104.6, 158702, 154404.8, 150202.4, 146086.4, 142045.8, 138123.5, 134267.5, 130524.8, 126856.3, 123279.5, 119786, 116382.1, 113030.4, 109789.8, 106610.1, 103522.2, 100495, 97546.49, 94680.41, 91884.89, 89159.28, 86509.24, 83913.33, 81384.58, 78929.11, 76511.08, 74192.81, 71924.03, 69700.78, 67546.64, 65454.75, 63403.13, 61413.65, 59490.48, 57626.25, 55808.55, 54029.62, 52281.77, 50589.61, 48961.97, 47355.9, 45812.47, 44315.23, 42871.87, 41459.38, 40081.5, 38750.31, 37464.04, 36206.42, 34989.98, 33813.26, 32690.52, 31578.15, 30501.2, 29451.84, 28431.79, 27462.63, 26508.64, 25596.95, 24709.57, 23867.98, 23036.22, 22217.44, 21426.29, 20652.39, 19899.95, 19203.9, 18511.66, 17823.33, 17181.94, 16560.89, 15963.73, 15394.27, 14802.42, 14291.11, 13767.51, 13246.78, 12769.51, 12280.36, 11848.46, 11455.59, 11008.82, 10633.78, 10254.89, 9851.731, 9477.698, 9103.009, 8739.014, 8389.005, 8070.73, 7814.482, 7514.394, 7241.072, 6954.014, 6690.682, 6449.786, 6196.138, 5947.059, 5716.999, 5509.189, 5307.05, 5120.287, 4944.31, 4717.335, 4551.082, 4341.208, 4158.323, 3979.001, 3798.403, 3617.087, 3416.431, 3291.677, 3157.915, 3038.735, 2919.05, 2799.194, 2683.417, 2572.067, 2481.325, 2398.014, 2308.712, 2216.651, 2145.61, 2065.591, 1995.94, 1911.789, 1844.481, 1764.773, 1679.618, 1623.248, 1561.992, 1473.281},
+	// precision 21
+	{1485608, 1458840, 1432376, 1406212, 1380353, 1354795, 1329536, 1304575, 1279920, 1255563, 1231520, 1207775, 1184330, 1161191, 1138353, 1115817, 1093586, 1071649, 1050016, 1028684, 1007641, 986904.8, 966461.2, 946307.6, 926443.3, 906884.7, 887606.4, 868619.3, 849926.6, 831514.1, 813404.2, 795580.9, 778026.3, 760759.4, 743785.1, 727077.8, 710641.8, 694486.1, 678594.1, 662972.1, 647623.3, 632551.6, 617730.4, 603171.3, 588869.3, 574838.7, 561036.2, 547509.2, 534241.3, 521230.5, 508451.7, 495908.4, 483609.8, 471541.4, 459719.1, 448139.6, 436767.9, 425654.4, 414744.3, 404070.4, 393588.1, 383348.4, 373323.7, 363511.1, 353905.8, 344513.9, 335296.6, 326294.3, 317478.7, 308877.2, 300416.9, 292187.6, 284131.7, 276295.8, 268605.7, 261078.7, 253734.5, 246571.9, 239594.1, 232756.3, 226093.5, 219588.4, 213283.8, 207107.9, 201057.7, 195134.7, 189417.3, 183785.4, 178336.5, 173026.3, 167838.3, 162768.4, 157809.3, 153001.6, 148332.3, 143764.6, 139313.1, 135007.6, 130828.5, 126731.1, 122747.6, 118912.8, 115178, 111544.5, 108007.6, 104580.8, 101239.1, 97950.65, 94760.4, 91668.03, 88676.68, 85800.85, 83012.06, 80304.09, 77635.5, 75089.59, 72608.56, 70200.35, 67831.19, 65575.92, 63383.29, 61240.23, 59135.55, 57100.04, 55144.93, 53272.57, 51457.61, 49722.71, 48030.74, 46353.43, 44715.8, 43157.53, 41626.98, 40174.7, 38785.08, 37414.2, 36086.87, 34821.1, 33559.26, 32367.28, 31234.14, 30069.97, 28980.92, 27933.12, 26919.84, 25931.43, 24991.72, 24076.05, 23256.97, 22380.56, 21581.19, 20814.67, 20045.11, 19332.82, 18659.84, 17995.74, 17331.39, 16721.51, 16108.94, 15544.99, 14984.97, 14414.33, 13864.69, 13324.63, 12825.22, 12353.98, 11929.22, 11440.19, 10985.53, 10531.04, 10099.89, 9716.231, 9385.449, 9000.705, 8608.644, 8256.218, 7927.235, 7595.168, 7320.797, 7056.409, 6761.406, 6574.313, 6251.201, 6004.59, 5771.469, 5509.424, 5327.817, 5102.235, 4876.969, 4658.753, 4468.624, 4276.486, 4076.206, 3913.879, 3763.084, 3577.522, 3380.635, 3223.319, 3082.6, 2985.182},
+	// precision 22
+	{2971215, 2917679, 2864750, 2812424, 2760700, 2709576, 2659060, 2609147, 2559838, 2511138, 2463044, 2415553, 2368672, 2322391, 2276722, 2231652, 2187197, 2143331, 2100069, 2057395, 2015328, 1973829, 1932928, 1892622, 1852892, 1813774, 1775238, 1737279, 1699896, 1663071, 1626844, 1591181, 1556086, 1521563, 1487592, 1454167, 1421311, 1388995, 1357210, 1325968, 1295279, 1265119, 1235482, 1206373, 1177797, 1149720, 1122180, 1095134, 1068569, 1042502, 1016956, 991884.9, 967292.1, 943200.8, 919547.5, 896347.5, 873593.9, 851337.6, 829540.3, 808173.3, 787229.5, 766747.6, 746655.8, 726995.1, 707729.7, 688945.5, 670513.5, 652510.4, 634874.9, 617634.3, 600801.5, 584321.9, 568236.9, 552533.9, 537137.8, 522092.6, 507421.3, 493101.4, 479121, 465467.8, 452158.1, 439105.1, 426400.8, 413993.6, 401906.2, 390114.2, 378621.1, 367397.9, 356451.5, 345772.4, 335387.4, 325263.3, 315446, 305885.2, 296551.5, 287513.2, 278695.1, 270093.9, 261744.9, 253599.9, 245632.2, 237921.1, 230449.1, 223137.5, 216080.3, 209169.2, 202454.7, 195922.6, 189627.3, 183452.2, 177495, 171714.8, 166002.4, 160543.3, 155260.7, 150132.6, 145105, 140254.8, 135562.5, 131007.9, 126591.3, 122343, 118182.8, 114116, 110181.4, 106424.7, 102768.3, 99184.08, 95734.22, 92374.66, 89156.52, 86021.47, 83032.64, 80120.86, 77265.34, 74539, 71890.45, 69304.26, 66821.46, 64395.89, 62072.78, 59824.12, 57582.94, 55533.08, 53554.7, 51560.54, 49621.71, 47754.75, 45919, 44248.71, 42635.53, 41013.38, 39483.59, 37999.73, 36591.9, 35290.65, 33931.68, 32700.17, 31432.17, 30241.22, 29151.53, 28087.93, 27037.84, 26022.36, 24980.35, 23937.58, 23000.93, 22129.63, 21297.16, 20487.05, 19677.15, 18931.74, 18197.03, 17431.77, 16723.73, 16057.13, 15339.8, 14774.96, 14145.77, 13584.11, 12958.42, 12491.43, 11926.68, 11462.01, 11058.27, 10638.26, 10208.88, 9775.312, 9327.089, 8892.117, 8500.789, 8161.642, 7778.363, 7402.908, 7102.14, 6779.789, 6469.712, 6194.399, 5920.67, 5636.04},
+	// precision 23
+	{5942437, 5835373, 5729513, 5624862, 5521412, 5419179, 5318156, 5218332, 5119727, 5022322, 4926136, 4831152, 4737380, 4644811, 4553460, 4463324, 4374391, 4286639, 4200110, 4114758, 4030617, 3947660, 3865885, 3785296, 3705874, 3627625, 3550528, 3474607, 3399831, 3326232, 3253764, 3182438, 3112245, 3043193, 2975239, 2908394, 2842656, 2778048, 2714519, 2652066, 2590661, 2530351, 2471094, 2412880, 2355675, 2299541, 2244426, 2190296, 2137202, 2085068, 2033946, 1983807, 1934615, 1886373, 1839052, 1792710, 1747301, 1702795, 1659166, 1616375, 1574516, 1533518, 1493362, 1454055, 1415610, 1377975, 1341151, 1305106, 1269846, 1235412, 1201734, 1168779, 1136533, 1105023, 1074288, 1044249, 1014945, 986315.1, 958349.4, 931039.6, 904371.5, 878367, 853011.3, 828212.3, 804026.3, 780501.7, 757562.8, 735230.3, 713371.7, 692122.2, 671420.7, 651245, 631563.8, 612382.7, 593649.7, 575419.2, 557699.7, 540441.4, 523690.1, 507353.5, 491543.3, 476146.2, 461153.7, 446625.7, 432450.8, 418644.3, 405188, 392180.8, 379521.4, 367250.9, 355353, 343782, 332571.8, 321652.4, 311091.8, 300876.5, 290832.6, 281208.7, 271747.1, 262589.4, 253717.1, 245150.8, 236867.2, 228820.4, 220928.7, 213305.7, 205980.2, 198855, 192061.9, 185479.1, 179042.9, 172793.8, 166776.7, 160900, 155244.8, 149701.4, 144456, 139392.4, 134421.7, 129622.4, 125017.9, 120646.4, 116293, 112038.5, 107969.5, 104048.4, 100291.3, 96658.08, 93069.72, 89651.12, 86371.78, 83166.59, 80153.49, 77213.53, 74458.11, 71695.1, 69015.72, 66558.25, 64069.94, 61616.03, 59343.03, 57087.38, 55007.58, 52970.82, 50915.07, 48983.21, 47134.31, 45311.42, 43583.05, 41856.26, 40272.23, 38620.99, 37199.48, 35861.27, 34479.83, 33121.36, 31791.39, 30521.26, 29262.08, 28016.79, 26986.26, 25920.21, 24907.93, 23851.87, 22936.62, 21988.23, 21068.14, 20186.51, 19329.76, 18519.79, 17747.22, 17139.78, 16449.95, 15724.92, 15036.38, 14427.58, 13756.16, 13206.31, 12688.42, 12147.67},
+	// precision 24
+	{1.188486e+07, 1.167074e+07, 1.145902e+07, 1.12497e+07, 1.104281e+07, 1.083835e+07, 1.063629e+07, 1.043667e+07, 1.023945e+07, 1.004466e+07, 9852294, 9662341, 9474814, 9289693, 9106989, 8926723, 8748850, 8573360, 8400283, 8229560, 8061240, 7895315, 7731739, 7570548, 7411716, 7255216, 7101049, 6949226, 6799724, 6652525, 6507577, 6364930, 6224519, 6086365, 5950466, 5816793, 5685349, 5556079, 5429000, 5304065, 5181285, 5060634, 4942122, 4825678, 4711389, 4599115, 4488909, 4380694, 4274508, 4170314, 4068060, 3967798, 3869434, 3772994, 3678428, 3585671, 3494746, 3405735, 3318413, 3232941, 3149179, 3067135, 2986891, 2908275, 2831384, 2756051, 2682434, 2610352, 2539853, 2470881, 2403485, 2337648, 2273261, 2210302, 2148803, 2088707, 2029981, 1972622, 1916715, 1862054, 1808729, 1756776, 1705932, 1656410, 1608163, 1560964, 1515001, 1470205, 1426501, 1383872, 1342410, 1301923, 1262485, 1224254, 1186978, 1150661, 1115174, 1080701, 1047170, 1014581, 982911.1, 952084.5, 922062.1, 892897, 864511.5, 836907.6, 810100.6, 784092.9, 758740.1, 734100.3, 710169.5, 686850.2, 664396.9, 642473.3, 621286.8, 600651.6, 580670, 561320, 542552.9, 524350.7, 506595, 489409.9, 472751.9, 456586.5, 441037.5, 425901.6, 411254.8, 397007.4, 383165.1, 369911.7, 356920.1, 344410.6, 332188, 320404.2, 309052.8, 298037.3, 287372.7, 277146.6, 267127.5, 257457.2, 248167.3, 239138.1, 230475.8, 221994, 213808.6, 205935, 198306.1, 190958.8, 183866.1, 177149.9, 170498.8, 164188.2, 157946.9, 151992.5, 146378.8, 140727, 135370.1, 130110.4, 125145.4, 120505.3, 115844, 111407, 107096.2, 103053.7, 99057.64, 95080.79, 91319.3, 87844.53, 84399.42, 81125.04, 77807.34, 74662.26, 71687.55, 68825.59, 66126.85, 63490.69, 60925.29, 58331.48, 55854.66, 53614.63, 51392.99, 49260.21, 47226.46, 45153.14, 43360.51, 41543.95, 39904.68, 38111.65, 36412.97, 34724.47, 33327.22, 31745.19, 30321.52, 28768.26, 27446.82, 26149.11, 25005.47, 23782.48, 22558.78, 21512.17},
+	// precision 25
+	{2.376973e+07, 2.334146e+07, 2.291803e+07, 2.249942e+07, 2.208563e+07, 2.167669e+07, 2.127259e+07, 2.087333e+07, 2.047892e+07, 2.008935e+07, 1.970461e+07, 1.932471e+07, 1.894963e+07, 1.857942e+07, 1.821401e+07, 1.78534e+07, 1.749762e+07, 1.714666e+07, 1.680049e+07, 1.645909e+07, 1.612246e+07, 1.579056e+07, 1.546343e+07, 1.514101e+07, 1.482334e+07, 1.451033e+07, 1.420201e+07, 1.389835e+07, 1.359927e+07, 1.33048e+07, 1.301491e+07, 1.272956e+07, 1.244869e+07, 1.217243e+07, 1.190065e+07, 1.163328e+07, 1.137035e+07, 1.111181e+07, 1.085767e+07, 1.060787e+07, 1.036233e+07, 1.012108e+07, 9884030, 9651157, 9422460, 9197908, 8977448, 8761060, 8548699, 8340217, 8135669, 7935093, 7738352, 7545470, 7356300, 7170824, 6989070, 6810902, 6636426, 6465404, 6297905, 6133903, 5973360, 5816296, 5662454, 5511968, 5364700, 5220639, 5079748, 4941841, 4807162, 4675482, 4546601, 4420615, 4297743, 4177650, 4060204, 3945549, 3833588, 3724288, 3617782, 3513776, 3412192, 3313153, 3216529, 3122360, 3030320, 2940714, 2853383, 2768253, 2685251, 2604510, 2525753, 2448944, 2374368, 2301606, 2230882, 2161901, 2094810, 2029427, 1965954, 1904192, 1844240, 1785918, 1729129, 1673863, 1620269, 1568235, 1517497, 1468239, 1420327, 1373823, 1328524, 1284813, 1242359, 1201201, 1161097, 1122187, 1084521, 1047952, 1012628, 978254.1, 944913.2, 912633.5, 881020.6, 850647.6, 821154, 792780.8, 765173.8, 738539.9, 712584.4, 687572.6, 663272.5, 639826.9, 617099.9, 595167.4, 573816.8, 553180.5, 533245.6, 514116.3, 495646.9, 477744.7, 460434.8, 443616.4, 427568, 412004.5, 397064.4, 382516.4, 368474, 354669.5, 341483, 328718.3, 316216.8, 304280, 292690.6, 281652.3, 270953.8, 260736.2, 250807, 241007.3, 232053.5, 223020.3, 214548.3, 206395.4, 198386.3, 190526.3, 183067.2, 175753.7, 168975.2, 162321.4, 156059.2, 149791.1, 143821.5, 138020.4, 132254.9, 126666.7, 121489.2, 116460.6, 111775.3, 107122.2, 102533.3, 98378.02, 94297.64, 90312.09, 86372.1, 82629.71, 79127.75, 75668.08, 72453.88, 69242.92, 66089.53, 63231.52, 60301.46, 57672.19, 54990.7, 52414.1, 49804.43, 47491.93, 45350.89, 43246.41},
 }
+
+// thresholdData is the largest linear counting estimate to use (see threshold), by precision.
+var thresholdData = [22]float64{10, 19, 40, 83, 166, 320, 691, 1280, 2560, 5530, 11469, 19661, 44237, 78643, 137626, 367002, 524288, 1.048576e+06, 1.677722e+06, 3.565158e+06, 9.646899e+06, 2.3488102e+07}
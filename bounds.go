@@ -0,0 +1,75 @@
+package hll
+
+import (
+	"math"
+)
+
+// EstimateWithBounds returns the EstimateCardinality estimate and an interval that holds the cardinality
+// with the given probability (confidence, say 0.95). Never modifies the HLL.
+// The relative standard error is taken as 1.04/sqrt(m) (a bit conservative just above the linear counting range),
+// or that of linear counting in its range (small cardinalities), which is lower.
+// The low bound is never below the number of non-zero registers: there were at least that many elements.
+// A confidence of 0 (or less) gives just the estimate, 1 an unbounded interval.
+func (h Dense) EstimateWithBounds(confidence float64) (estimate, low, high uint64) {
+	m := h.m()
+	e := float64(h.EstimateCardinality())
+	V := h.NumZeroRegisters()
+	stdErr := 1.04 / math.Sqrt(float64(m))
+	if V != 0 && linearCounting(m, V) <= threshold(m) {
+		stdErr = linearCountingError(m, e)
+	}
+	low, high = bounds(e, stdErr, confidence, uint64(m-V))
+	return round(e), low, high
+}
+
+// EstimateWithBounds returns a cardinality estimate and an interval that holds the cardinality with the given probability
+// (see Dense.EstimateWithBounds). Never modifies the HLL.
+// A sparse HLL is exact, so the interval is just the estimate. Unless it is compressed: the interval is
+// that of linear counting over 2^25 registers, very narrow.
+func (h HLL) EstimateWithBounds(confidence float64) (estimate, low, high uint64) {
+	if h[0]&(1<<6) != 0 {
+		return Dense(h[8:]).EstimateWithBounds(confidence)
+	}
+	s := sparse(h)
+	if !s.compressed() {
+		c := h.PeekCardinality()
+		return c, c, c
+	}
+	n := len(s.uniqueEntries())
+	e := compressedEstimate(n)
+	low, high = bounds(float64(e), linearCountingError(1<<sparsePrecision, float64(e)), confidence, uint64(n))
+	return e, low, high
+}
+
+// linearCountingError returns the relative standard error of linear counting over m registers for n elements:
+// sqrt(m * (e^t - t - 1)) / n, t = n / m (see "A linear-time probabilistic counting algorithm
+// for database applications" by Kyu-Young Whang et al.).
+func linearCountingError(m int, n float64) float64 {
+	if n == 0 {
+		return 0
+	}
+	t := n / float64(m)
+	return math.Sqrt(float64(m)*(math.Expm1(t)-t)) / n
+}
+
+// bounds returns e -/+ z * stdErr * e, z being the normal quantile for the confidence, with low clamped at atLeast.
+func bounds(e, stdErr, confidence float64, atLeast uint64) (low, high uint64) {
+	d := 0.0
+	if confidence > 0 {
+		d = math.Sqrt2 * math.Erfinv(math.Min(confidence, 1)) * stdErr * e
+	}
+	low, high = round(e), round(e)
+	if d > 0 {
+		low, high = 0, round(math.Ceil(e+d))
+		if e-d > 0 {
+			low = uint64(math.Floor(e - d))
+		}
+	}
+	if low < atLeast {
+		low = atLeast
+	}
+	if high < low {
+		high = low
+	}
+	return low, high
+}
@@ -0,0 +1,96 @@
+package hll
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEstimateWithBoundsSparse(t *testing.T) {
+	s, err := SizeByP(12)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	for i := 0; i < 200; i++ {
+		h.Add(xxHash64Uint64(uint64(i)))
+		h.Add(xxHash64Uint64(uint64(i)))
+	}
+	if e, low, high := h.EstimateWithBounds(0.99); e != 200 || low != 200 || high != 200 {
+		t.Fatal(e, low, high)
+	}
+	h.CompressSparse()
+	e, low, high := h.EstimateWithBounds(0.99)
+	if e != 200 || low != 200 || high < 200 || high > 201 {
+		t.Fatal(e, low, high)
+	}
+}
+
+func TestEstimateWithBoundsEdges(t *testing.T) {
+	s, err := DenseSizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(Dense, s)
+	if e, low, high := h.EstimateWithBounds(0.95); e != 0 || low != 0 || high != 0 {
+		t.Fatal(e, low, high)
+	}
+	for i := 0; i < 5000; i++ {
+		h.Add(xxHash64Uint64(uint64(i)))
+	}
+	e, low, high := h.EstimateWithBounds(0)
+	if e != h.EstimateCardinality() || low != e || high != e {
+		t.Fatal(e, low, high)
+	}
+	if _, low, high := h.EstimateWithBounds(1); low != uint64(h.m()-h.NumZeroRegisters()) || high != math.MaxUint64 {
+		t.Fatal(low, high)
+	}
+	_, low90, high90 := h.EstimateWithBounds(0.9)
+	_, low99, high99 := h.EstimateWithBounds(0.99)
+	if !(low99 < low90 && low90 < e && e < high90 && high90 < high99) {
+		t.Fatal(low99, low90, e, high90, high99)
+	}
+}
+
+// TestEstimateWithBoundsCoverage checks that about 95% of the 95% intervals hold the cardinality,
+// in the linear counting range and above it.
+// Just above the linear counting range the bias corrected estimate is better than 1.04/sqrt(m), so the intervals are conservative there.
+func TestEstimateWithBoundsCoverage(t *testing.T) {
+	const trials = 400
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	checkpoints := []int{300, 1000, 2000, 5000, 20000}
+	var hits [5]int
+	var width [5]float64
+	rnd := rand.New(rand.NewSource(1))
+	for k := 0; k < trials; k++ {
+		h.Reset()
+		n := 0
+		for j, c := range checkpoints {
+			for ; n < c; n++ {
+				h.Add(xxHash64Uint64(rnd.Uint64()))
+			}
+			e, low, high := h.EstimateWithBounds(0.95)
+			if e != h.PeekCardinality() {
+				t.Fatal(e, h.PeekCardinality())
+			}
+			if low <= uint64(n) && uint64(n) <= high {
+				hits[j]++
+			}
+			width[j] += float64(high-low) / float64(n) / trials
+		}
+	}
+	for j, c := range checkpoints {
+		if p := float64(hits[j]) / trials; p < 0.93 || p > 0.995 {
+			t.Error(c, p, width[j])
+		}
+	}
+	// Linear counting is more accurate for small cardinalities.
+	if width[0] > 0.8*width[4] {
+		t.Error(width)
+	}
+}
@@ -0,0 +1,272 @@
+package hll
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// compressed is an alternative sparse encoding (HLL++ style), selected with HLL.CompressSparse.
+// Rather than the hashes it keeps entries: the low 25 bits of a hash (the register index at the sparse precision)
+// and its register value (rho), which is enough to set the register of any precision.
+// With the standard layout (see SetStandardLayout) these are the top 25 bits and rho of the other 39 (see standardEntry).
+//
+// Layout:
+// First 32 bits (big endian): dirty (leftmost bit), mode (0, sparse), layout, number of tail entries (29 bits).
+// Next 32 bits: compressed flag (leftmost bit), byte size of the list (31 bits).
+// The list: sorted entries (index << 6 | rho), one per index (with the largest rho), as varint encoded differences.
+// The tail: unsorted entries added since the list was written, 4 bytes each (big endian), growing backwards from the end.
+// Dirty iff the tail is not empty.
+//
+// An entry takes 1-4 bytes in the list (about 3 when the HLL gets full), so the HLL stays sparse for about 2.5 times as many elements.
+// The estimate is linear counting over 2^25 registers: not exact, but very close for small cardinalities.
+type compressed []byte
+
+const (
+	sparsePrecision = 25
+	compressedFlag  = 1 << 31
+)
+
+// CompressSparse switches a sparse HLL to the compressed sparse encoding: instead of 8 bytes per hash
+// it keeps sorted, delta encoded (25-bit index, register value) pairs, HLL++ style.
+// The HLL stays sparse for about 2.5 times as many elements, the estimate is close to exact rather than exact.
+// Does nothing if the HLL is dense or already compressed. Might allocate a block (with Alloc).
+//
+// The encoding is kept until the HLL turns dense or is Reset.
+// Merging a compressed HLL into a sparse one compresses it as well.
+func (h HLL) CompressSparse() {
+	if h[0]&(1<<6) != 0 {
+		return
+	}
+	compress(sparse(h))
+}
+
+// IsCompressed returns true iff the HLL is sparse with the compressed encoding (see CompressSparse).
+func (h HLL) IsCompressed() bool {
+	return h[0]&(1<<6) == 0 && sparse(h).compressed()
+}
+
+func (s sparse) compressed() bool {
+	return s[4]&(1<<7) != 0
+}
+
+// compress converts the hashes of s into a compressed list, in place.
+func compress(s sparse) {
+	if s.compressed() {
+		return
+	}
+	es := s.uniqueEntries()
+	c := compressed(s)
+	w := listWriter{b: c[8:]}
+	for _, e := range es {
+		w.add(e) // Always fits: an entry takes at most 5 bytes, a hash 8.
+	}
+	w.flush()
+	for i := 8 + w.n; i < len(c); i++ {
+		c[i] = 0
+	}
+	c.setSizes(w.n, 0)
+}
+
+// compressedEntry returns the entry for a hash: its low 25 bits and the register value.
+func compressedEntry(hash uint64) uint32 {
+	return uint32(hash&(1<<sparsePrecision-1))<<6 | uint32(rho(hash))
+}
+
+// compressedHash returns a hash with entry e, so it sets the same register as the original hash at any precision.
+func compressedHash(e uint32) uint64 {
+	idx, rho := uint64(e>>6), e&63
+	if rho <= 64-sparsePrecision {
+		return 1<<(64-rho) | idx
+	}
+	return idx // The leading zeros run into the index.
+}
+
+func (c compressed) tailSize() int {
+	return int(binary.BigEndian.Uint32(c) & (1<<29 - 1))
+}
+
+func (c compressed) listSize() int {
+	return int(binary.BigEndian.Uint32(c[4:]) &^ compressedFlag)
+}
+
+func (c compressed) setSizes(list, tail int) {
+	x := uint32(tail) | uint32(c[0]&standardFlag)<<24
+	if tail > 0 {
+		x |= 1 << 31 // Dirty.
+	}
+	binary.BigEndian.PutUint32(c, x)
+	binary.BigEndian.PutUint32(c[4:], compressedFlag|uint32(list))
+}
+
+func (c compressed) free() int {
+	return len(c) - 8 - c.listSize() - 4*c.tailSize()
+}
+
+// Add an entry for hash to the tail, merging the tail into the list once there is no room.
+func (c compressed) Add(hash uint64) addResult {
+	if c.free() < 4 {
+		// Keep some room after merging, so we do not merge on every add once the HLL is almost full.
+		if c.flush() == full || c.free() < len(c)>>5+4 {
+			return full
+		}
+	}
+	t := c.tailSize() + 1
+	binary.BigEndian.PutUint32(c[len(c)-4*t:], sparse(c).entry(hash))
+	c.setSizes(c.listSize(), t)
+	return ok
+}
+
+// flush merges the tail into the list (clears dirty). Returns full, leaving c intact, if the result does not fit.
+// Might allocate a block (with Alloc).
+func (c compressed) flush() addResult {
+	t := c.tailSize()
+	if t == 0 {
+		return ok
+	}
+	tail := c[len(c)-4*t:]
+	sort.Sort(sortable32(tail))
+	tmp := Alloc(len(c) - 8)
+	w := listWriter{b: tmp}
+	r := listReader{b: c[8 : 8+c.listSize()]}
+	e, more := r.next()
+	for i := 0; more || i < len(tail); {
+		if i == len(tail) || more && e <= binary.BigEndian.Uint32(tail[i:]) {
+			if !w.add(e) {
+				Free(tmp)
+				return full
+			}
+			e, more = r.next()
+			continue
+		}
+		if !w.add(binary.BigEndian.Uint32(tail[i:])) {
+			Free(tmp)
+			return full
+		}
+		i += 4
+	}
+	if !w.flush() {
+		Free(tmp)
+		return full
+	}
+	copy(c[8:], tmp[:w.n])
+	Free(tmp)
+	for i := 8 + w.n; i < len(c); i++ {
+		c[i] = 0
+	}
+	c.setSizes(w.n, 0)
+	return ok
+}
+
+// forEach calls f for every entry (the list, then the tail) until f returns false.
+// Tail entries might repeat the list ones.
+func (c compressed) forEach(f func(e uint32) bool) bool {
+	r := listReader{b: c[8 : 8+c.listSize()]}
+	for e, more := r.next(); more; e, more = r.next() {
+		if !f(e) {
+			return false
+		}
+	}
+	for i := len(c) - 4; i >= len(c)-4*c.tailSize(); i -= 4 {
+		if !f(binary.BigEndian.Uint32(c[i:])) {
+			return false
+		}
+	}
+	return true
+}
+
+// EstimateCardinality returns the linear counting estimate of a clean (flushed) list.
+func (c compressed) EstimateCardinality() uint64 {
+	n := 0
+	for _, b := range c[8 : 8+c.listSize()] {
+		if b < 0x80 { // Last byte of a varint.
+			n++
+		}
+	}
+	return compressedEstimate(n)
+}
+
+// compressedEstimate returns the estimate for n distinct 25-bit indexes.
+func compressedEstimate(n int) uint64 {
+	const m = 1 << sparsePrecision
+	if n >= m {
+		n = m - 1 // Can not happen: the list would not fit.
+	}
+	return round(linearCounting(m, m-n))
+}
+
+// listWriter writes sorted entries as varint encoded differences, keeping the last entry (the largest rho) per index.
+type listWriter struct {
+	b       []byte
+	n       int
+	prev    uint32
+	pending uint32
+	has     bool
+}
+
+// add an entry, not smaller than the previous one. Returns false if there is no room.
+func (w *listWriter) add(e uint32) bool {
+	if w.has && e>>6 == w.pending>>6 {
+		w.pending = e
+		return true
+	}
+	if !w.flush() {
+		return false
+	}
+	w.pending, w.has = e, true
+	return true
+}
+
+// flush writes the pending entry. Returns false if there is no room.
+func (w *listWriter) flush() bool {
+	if !w.has {
+		return true
+	}
+	var buf [binary.MaxVarintLen32]byte
+	k := binary.PutUvarint(buf[:], uint64(w.pending-w.prev))
+	if k > len(w.b)-w.n {
+		return false
+	}
+	copy(w.b[w.n:], buf[:k])
+	w.n += k
+	w.prev, w.has = w.pending, false
+	return true
+}
+
+// listReader reads a list written by listWriter. Stops at a malformed varint (see Validate).
+type listReader struct {
+	b []byte
+	e uint32
+}
+
+func (r *listReader) next() (uint32, bool) {
+	if len(r.b) == 0 {
+		return 0, false
+	}
+	d, k := binary.Uvarint(r.b)
+	if k <= 0 {
+		r.b = nil
+		return 0, false
+	}
+	r.b = r.b[k:]
+	r.e += uint32(d)
+	return r.e, true
+}
+
+// sortable32 is a list of 4 byte big endian entries.
+type sortable32 []byte
+
+func (s sortable32) Len() int {
+	return len(s) >> 2
+}
+
+func (s sortable32) Swap(i, j int) {
+	i <<= 2
+	j <<= 2
+	for k := 0; k < 4; k++ {
+		s[i+k], s[j+k] = s[j+k], s[i+k]
+	}
+}
+
+func (s sortable32) Less(i, j int) bool {
+	return binary.BigEndian.Uint32(s[i<<2:]) < binary.BigEndian.Uint32(s[j<<2:])
+}
@@ -0,0 +1,240 @@
+package hll
+
+import (
+	"bytes"
+	"log"
+	"math"
+	"testing"
+)
+
+func TestCompressedEntry(t *testing.T) {
+	hashes := []uint64{0, 1, 2, 1 << 24, 1 << 25, 1<<25 | 1<<24, 1 << 38, 1 << 39, 1 << 63, ^uint64(0)}
+	for i := 0; i < 10000; i++ {
+		hashes = append(hashes, xorShift64StarRound(i+1), xorShift64StarRound(i+1)>>uint(i%64))
+	}
+	for _, p := range []int{4, 14, 25} {
+		s, err := DenseSizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		d := make(Dense, s)
+		for _, x := range hashes {
+			e := compressedEntry(x)
+			y := compressedHash(e)
+			if compressedEntry(y) != e {
+				t.Fatal(x, e, y)
+			}
+			ix, rx := d.register(x)
+			iy, ry := d.register(y)
+			if ix != iy || rx != ry {
+				t.Fatal(p, x, y)
+			}
+		}
+	}
+}
+
+func TestCompressSparse(t *testing.T) {
+	s, err := SizeByP(14)
+	if err != nil {
+		log.Panicln(err)
+	}
+	plain := make(HLL, s)
+	h := make(HLL, s)
+	h.CompressSparse()
+	if !h.IsCompressed() || !h.IsSparse() || h.EstimateCardinality() != 0 {
+		t.Fatal("empty compressed hll")
+	}
+	for i := 0; i < 3500; i++ {
+		x := xorShift64StarRound(i + 1)
+		plain.Add(x)
+		h.Add(x)
+		h.Add(x)
+		if i == 100 || i == 1000 || i == 3499 {
+			if err := h.Validate(); err != nil {
+				t.Fatal(i, err)
+			}
+			if c := h.PeekCardinality(); c != h.EstimateCardinality() || math.Abs(float64(c)-float64(i+1)) > float64(i+1)/1000+1 {
+				t.Fatal(i, c)
+			}
+			if err := h.Validate(); err != nil {
+				t.Fatal(i, err)
+			}
+		}
+	}
+	// The plain encoding holds 1536 hashes, the compressed one more than twice as many.
+	if plain.IsSparse() || !h.IsCompressed() {
+		t.Fatal("3500 elements should fit into compressed sparse only")
+	}
+	if !bytes.Equal(toDenseHLL(h)[8:], toDenseHLL(plain)[8:]) {
+		t.Fatal("registers differ")
+	}
+	for i := 3500; i < 20000; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+		plain.Add(xorShift64StarRound(i + 1))
+	}
+	if h.IsSparse() || h.IsCompressed() {
+		t.Fatal("20000 elements should not fit")
+	}
+	if !bytes.Equal(h[8:], plain[8:]) {
+		t.Fatal("registers differ")
+	}
+}
+
+func TestCompressSparseExisting(t *testing.T) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	for i := 0; i < 90; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	g := append(HLL(nil), h...)
+	h.CompressSparse()
+	if !h.IsCompressed() || h.EstimateCardinality() != 90 {
+		t.Fatal(h.EstimateCardinality())
+	}
+	if !bytes.Equal(toDenseHLL(h), toDenseHLL(g)) {
+		t.Fatal("registers differ")
+	}
+	h.Reset()
+	if h.IsCompressed() {
+		t.Fatal("Reset should restore the plain encoding")
+	}
+}
+
+func TestMergeCompressed(t *testing.T) {
+	s, err := SizeByP(12)
+	if err != nil {
+		log.Panicln(err)
+	}
+	a, b := make(HLL, s), make(HLL, s)
+	b.CompressSparse()
+	for i := 0; i < 300; i++ {
+		a.Add(xorShift64StarRound(i + 1))
+		b.Add(xorShift64StarRound(i + 201))
+	}
+	if c, err := UnionEstimate(a, b); err != nil || c != 500 {
+		t.Fatal(c, err)
+	}
+	if c, err := IntersectionEstimate(a, b); err != nil || c != 100 {
+		t.Fatal(c, err)
+	}
+	c := append(HLL(nil), b...)
+	if err := c.Merge(a); err != nil {
+		t.Fatal(err)
+	}
+	if !c.IsCompressed() || c.EstimateCardinality() != 500 {
+		t.Fatal(c.EstimateCardinality())
+	}
+	// Merging a compressed HLL into a plain one compresses it.
+	if err := a.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+	if !a.IsCompressed() || a.EstimateCardinality() != 500 {
+		t.Fatal(a.EstimateCardinality())
+	}
+	if err := a.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(toDenseHLL(a), toDenseHLL(c)) {
+		t.Fatal("registers differ")
+	}
+}
+
+func TestFoldCompressed(t *testing.T) {
+	s, err := SizeByP(14)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	h.CompressSparse()
+	for i := 0; i < 500; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	g, err := append(HLL(nil), h...).Fold(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsCompressed() || g.EstimateCardinality() != h.EstimateCardinality() {
+		t.Fatal(g.EstimateCardinality(), h.EstimateCardinality())
+	}
+	if err := g.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	g, err = g.Fold(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.IsSparse() {
+		t.Fatal("500 elements should not fit into p = 8")
+	}
+	d := toDenseHLL(h)
+	d, err = d.Fold(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(g[8:], d[8:]) {
+		t.Fatal("registers differ")
+	}
+}
+
+func TestValidateCompressed(t *testing.T) {
+	s, err := SizeByP(8)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	h.CompressSparse()
+	h.Add(1 << 40)   // Index 0, rho 24: entry 24.
+	h.Add(1<<50 | 1) // Index 1, rho 14: entry 78.
+	h.EstimateCardinality()
+	if err := h.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(h[8:10], []byte{24, 78 - 24}) {
+		t.Fatal(h[:10])
+	}
+	for _, c := range []struct {
+		off  int
+		b    byte
+		grow bool
+	}{
+		{8, 0, false},        // First entry has rho 0.
+		{9, 0, false},        // Second entry has the same index.
+		{9, 114 - 24, false}, // Index 1 with rho 50 can not come from a hash.
+		{10, 1, true},        // An extra entry with the same index.
+		{9, 0x80, false},     // Truncated varint.
+	} {
+		g := append(HLL(nil), h...)
+		g[c.off] = c.b
+		if c.grow {
+			g[7]++
+		}
+		if err := g.Validate(); err == nil {
+			t.Fatal("expected corruption at", c.off)
+		}
+	}
+	// A tail entry out of range.
+	g := append(HLL(nil), h...)
+	compressed(g).setSizes(compressed(g).listSize(), 1)
+	g[len(g)-1] = 0
+	if err := g.Validate(); err == nil {
+		t.Fatal("expected corruption in the tail")
+	}
+}
+
+func BenchmarkAddCompressed(b *testing.B) {
+	s, _ := SizeByP(14)
+	h := make(HLL, s)
+	h.CompressSparse()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i <= b.N; i++ {
+		h.Add(xorShift64StarRound(i))
+		if !h.IsSparse() {
+			h.Reset()
+			h.CompressSparse()
+		}
+	}
+}
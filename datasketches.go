@@ -0,0 +1,392 @@
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// Apache DataSketches HLL interoperability (serialization version 1).
+//
+// Layout (little endian), the preamble:
+//
+//	1 byte: preamble size in 4 byte ints (2 LIST, 3 SET, 10 HLL).
+//	1 byte: serialization version, 1.
+//	1 byte: family, 7.
+//	1 byte: lgK (the precision).
+//	1 byte: lgArr, log2 of the coupon array size (LIST, SET) or of the aux array size (HLL_4).
+//	1 byte: flags (4 empty, 8 compact, 16 out of order).
+//	1 byte: number of coupons (LIST) or the smallest register value (HLL_4).
+//	1 byte: mode (low 2 bits: 0 LIST, 1 SET, 2 HLL) and type (next 2 bits: 0 HLL_4, 1 HLL_6, 2 HLL_8).
+//
+// SET: 4 bytes, number of coupons.
+// HLL: 8 bytes HIP estimate, 16 bytes (2 halves of) the sum of 2^-register, 4 bytes number of registers at the smallest value,
+// 4 bytes number of aux entries (HLL_4).
+//
+// followed by the data:
+// LIST, SET: coupons, 4 bytes each (a hash table with zeros for empty slots, unless compact).
+// HLL_4: 4 bit registers (relative to the smallest value, 15 means look up the aux entries), followed by the aux entries (coupons).
+// HLL_6: 6 bit registers, packed from the least significant bit. HLL_8: a byte per register.
+//
+// A coupon is a register value (6 bits) followed by a 26 bit address, the register index is the low lgK bits of the address.
+// DataSketches takes both from a 128-bit Murmur3 (seed 9001): the address from the low bits of the first half,
+// the value from the leading zeros of the second.
+// DataSketchesHash converts the pair into a go-hll hash, so sketches fed with the same elements have the same registers.
+// Coupons map to a sparse HLL (exact, as in DataSketches), HLL mode to a dense one.
+
+// DataSketchesType is the target register width of a DataSketches HLL sketch.
+type DataSketchesType byte
+
+const (
+	// DataSketchesHLL4 is HLL_4: 4 bit registers plus exceptions (the default in DataSketches).
+	DataSketchesHLL4 DataSketchesType = 0
+	// DataSketchesHLL6 is HLL_6: 6 bit registers.
+	DataSketchesHLL6 DataSketchesType = 1
+	// DataSketchesHLL8 is HLL_8: 8 bit registers.
+	DataSketchesHLL8 DataSketchesType = 2
+)
+
+// DataSketchesSeed is the default update seed of DataSketches.
+const DataSketchesSeed = 9001
+
+const (
+	dsSerVer      = 1
+	dsFamily      = 7
+	dsEmptyFlag   = 4
+	dsCompactFlag = 8
+	dsOOOFlag     = 16
+	dsList        = 0
+	dsSet         = 1
+	dsHLL         = 2
+	dsAddressBits = 26
+	dsAuxToken    = 15
+	dsMaxLgK      = 21
+)
+
+// DataSketchesHash returns the hash of b for an HLL (with p up to 21) that puts it in the same register as
+// DataSketches HllSketch.update does. Update with a long hashes its 8 little endian bytes, with a string its UTF-8 bytes.
+func DataSketchesHash(b []byte) uint64 {
+	h0, h1 := Murmur3(b, DataSketchesSeed)
+	lz := bits.LeadingZeros64(h1)
+	if lz > 62 {
+		lz = 62
+	}
+	return dsCouponHash(uint32(lz+1)<<dsAddressBits | uint32(h0)&(1<<dsAddressBits-1))
+}
+
+// dsCouponHash returns a hash with the address of a coupon in the low bits and the value as the leading zeros.
+// Values above 38 (that is, with probability 2^-38) are capped: the address takes the low 26 bits.
+func dsCouponHash(c uint32) uint64 {
+	shift := 64 - uint(c>>dsAddressBits)
+	if shift < dsAddressBits {
+		shift = dsAddressBits
+	}
+	return uint64(c&(1<<dsAddressBits-1)) | 1<<shift
+}
+
+// dsCoupon returns a coupon for a go-hll hash.
+func dsCoupon(hash uint64) uint32 {
+	return uint32(rho(hash))<<dsAddressBits | uint32(hash)&(1<<dsAddressBits-1)
+}
+
+// DecodeDataSketches converts a serialized DataSketches HLL sketch (compact or updatable) into a new HLL with p = lgK,
+// returning the target type as well.
+// Register values a go-hll hash can not produce (see Validate) are capped.
+func DecodeDataSketches(b []byte) (HLL, DataSketchesType, error) {
+	if len(b) < 8 {
+		return nil, 0, errors.New("datasketches hll is too short")
+	}
+	preInts, lgK, lgArr, flags, mode := int(b[0]), int(b[3]), int(b[4]), b[5], b[7]
+	t := DataSketchesType(mode >> 2 & 3)
+	if b[1] != dsSerVer || b[2] != dsFamily {
+		return nil, t, errors.New("not a datasketches hll (version 1)")
+	}
+	if t > DataSketchesHLL8 {
+		return nil, t, errors.New("unknown datasketches hll type")
+	}
+	if lgK > dsMaxLgK {
+		return nil, t, errors.New("datasketches lgK is too large")
+	}
+	s, err := SizeByP(lgK)
+	if err != nil {
+		return nil, t, err
+	}
+	h := make(HLL, s)
+	compact := flags&dsCompactFlag != 0
+	switch mode & 3 {
+	case dsList, dsSet:
+		var n int
+		if mode&3 == dsList {
+			n = int(b[6])
+			if preInts != 2 {
+				return nil, t, errors.New("bad datasketches preamble size")
+			}
+		} else {
+			if preInts != 3 || len(b) < 12 {
+				return nil, t, errors.New("bad datasketches preamble size")
+			}
+			n = int(binary.LittleEndian.Uint32(b[8:]))
+		}
+		if !compact {
+			if lgArr > 26 {
+				return nil, t, errors.New("datasketches coupon array is too large")
+			}
+			n = 1 << uint(lgArr)
+		}
+		if flags&dsEmptyFlag != 0 {
+			n = 0
+		}
+		data := b[4*preInts:]
+		if n < 0 || len(data)/4 < n {
+			return nil, t, errors.New("datasketches coupons are truncated")
+		}
+		for i := 0; i < n; i++ {
+			if c := binary.LittleEndian.Uint32(data[4*i:]); c != 0 {
+				if c>>dsAddressBits == 0 {
+					return nil, t, errors.New("datasketches coupon has zero value")
+				}
+				h.Add(dsCouponHash(c))
+			}
+		}
+		h.EstimateCardinality() // Sort.
+		return h, t, nil
+	case dsHLL:
+		if preInts != 10 || len(b) < 40 {
+			return nil, t, errors.New("bad datasketches preamble size")
+		}
+	default:
+		return nil, t, errors.New("unknown datasketches hll mode")
+	}
+	d := Dense(h[8:])
+	m := d.m()
+	data := b[40:]
+	set := func(i int, v byte) {
+		// DataSketches registers can be larger than go-hll ones: its hash has bits to spare.
+		if max := maxRegister(int(d.p()), i, false); v > max {
+			v = max
+		}
+		d.set(i, v)
+	}
+	switch t {
+	case DataSketchesHLL8:
+		if len(data) < m {
+			return nil, t, errors.New("datasketches registers are truncated")
+		}
+		for i := 0; i < m; i++ {
+			if data[i] > 63 {
+				return nil, t, errors.New("datasketches register is out of range")
+			}
+			set(i, data[i])
+		}
+	case DataSketchesHLL6:
+		if len(data) < m*3/4+1 {
+			return nil, t, errors.New("datasketches registers are truncated")
+		}
+		for i := 0; i < m; i++ {
+			set(i, redisGet(data, i)) // HLL_6 packs registers as Redis does.
+		}
+	case DataSketchesHLL4:
+		curMin := b[6]
+		if len(data) < m/2 {
+			return nil, t, errors.New("datasketches registers are truncated")
+		}
+		if curMin > 63 {
+			return nil, t, errors.New("datasketches register is out of range")
+		}
+		for i := 0; i < m; i++ {
+			v := data[i/2] >> (4 * uint(i&1)) & 15
+			if v == dsAuxToken {
+				continue // Set from the aux entries below.
+			}
+			if v += curMin; v > 63 {
+				return nil, t, errors.New("datasketches register is out of range")
+			}
+			set(i, v)
+		}
+		n := int(binary.LittleEndian.Uint32(b[36:]))
+		if !compact {
+			if lgArr > 26 {
+				return nil, t, errors.New("datasketches aux array is too large")
+			}
+			n = 1 << uint(lgArr)
+		}
+		aux := data[m/2:]
+		if n < 0 || len(aux)/4 < n {
+			return nil, t, errors.New("datasketches aux entries are truncated")
+		}
+		for i := 0; i < n; i++ {
+			c := binary.LittleEndian.Uint32(aux[4*i:])
+			if c == 0 {
+				continue
+			}
+			idx := int(c & uint32(m-1))
+			if data[idx/2]>>(4*uint(idx&1))&15 != dsAuxToken {
+				return nil, t, errors.New("datasketches aux entry for a regular register")
+			}
+			set(idx, byte(c>>dsAddressBits))
+		}
+	}
+	h[0] = 128 + 64 // dirty + dense
+	return h, t, nil
+}
+
+// EncodeDataSketches converts an HLL (with p up to 21) into a compact DataSketches HLL sketch,
+// that HllSketch.heapify (or Union) can read.
+// A sparse HLL is written as coupons (LIST or SET, as DataSketches would), a dense one in HLL mode of type t.
+// HLL mode sketches are marked out of order, so DataSketches does not use its HIP estimate
+// (go-hll does not keep one) and estimates from the registers.
+func EncodeDataSketches(h HLL, t DataSketchesType) ([]byte, error) {
+	if err := h.IsValid(); err != nil {
+		return nil, err
+	}
+	if h.IsStandardLayout() {
+		return nil, errors.New("standard layout is not supported")
+	}
+	if t > DataSketchesHLL8 {
+		return nil, errors.New("unknown datasketches hll type")
+	}
+	d := Dense(h[8:])
+	lgK := int(d.p())
+	if lgK > dsMaxLgK {
+		return nil, errors.New("datasketches lgK must be at most 21")
+	}
+	if h[0]&(1<<6) == 0 {
+		hashes := sparse(h).uniqueHashes()
+		coupons := make([]uint32, 0, len(hashes))
+		for _, x := range hashes {
+			coupons = append(coupons, dsCoupon(x))
+		}
+		sort.Slice(coupons, func(i, j int) bool { return coupons[i] < coupons[j] })
+		n := 0
+		for i, c := range coupons {
+			if i == 0 || c != coupons[i-1] {
+				coupons[n] = c
+				n++
+			}
+		}
+		coupons = coupons[:n]
+		// LIST holds up to 7 coupons, SET up to 3/4 of 2^(lgK-3) (there is no SET for lgK < 8).
+		lgArr := 5
+		for 4*n > 3<<uint(lgArr) {
+			lgArr++
+		}
+		switch {
+		case n < 8:
+			b := make([]byte, 8+4*n)
+			dsPreamble(b, 2, lgK, 3, dsList, t)
+			b[6] = byte(n)
+			if n == 0 {
+				b[5] |= dsEmptyFlag
+			}
+			for i, c := range coupons {
+				binary.LittleEndian.PutUint32(b[8+4*i:], c)
+			}
+			return b, nil
+		case lgK >= 8 && lgArr <= lgK-3:
+			b := make([]byte, 12+4*n)
+			dsPreamble(b, 3, lgK, lgArr, dsSet, t)
+			binary.LittleEndian.PutUint32(b[8:], uint32(n))
+			for i, c := range coupons {
+				binary.LittleEndian.PutUint32(b[12+4*i:], c)
+			}
+			return b, nil
+		}
+		d = make(Dense, len(h)-8)
+		mergeIntoDense(d, sparse(h))
+	}
+
+	m := d.m()
+	curMin := byte(63)
+	var kxq0, kxq1 float64
+	for i := 0; i < m; i++ {
+		v := d.get(i)
+		if v < curMin {
+			curMin = v
+		}
+		if v < 32 {
+			kxq0 += lookup[v]
+		} else {
+			kxq1 += lookup[v]
+		}
+	}
+	if t != DataSketchesHLL4 {
+		curMin = 0
+	}
+	var numAtCurMin, lgArr int
+	var aux []uint32
+	for i := 0; i < m; i++ {
+		v := d.get(i)
+		if v == curMin {
+			numAtCurMin++
+		}
+		if t == DataSketchesHLL4 && v-curMin >= dsAuxToken {
+			aux = append(aux, uint32(v)<<dsAddressBits|uint32(i))
+		}
+	}
+	var regs []byte
+	switch t {
+	case DataSketchesHLL4:
+		regs = make([]byte, m/2+4*len(aux))
+		for i := 0; i < m; i++ {
+			v := d.get(i) - curMin
+			if v > dsAuxToken {
+				v = dsAuxToken
+			}
+			regs[i/2] |= v << (4 * uint(i&1))
+		}
+		for i, c := range aux {
+			binary.LittleEndian.PutUint32(regs[m/2+4*i:], c)
+		}
+		lgArr = dsLgAuxArrInts[lgK]
+		for 4*len(aux) > 3<<uint(lgArr) {
+			lgArr++
+		}
+	case DataSketchesHLL6:
+		regs = make([]byte, m*3/4+1)
+		for i := 0; i < m; i++ {
+			redisSet(regs, i, d.get(i))
+		}
+	case DataSketchesHLL8:
+		regs = make([]byte, m)
+		for i := 0; i < m; i++ {
+			regs[i] = d.get(i)
+		}
+	}
+	b := make([]byte, 40+len(regs))
+	dsPreamble(b, 10, lgK, lgArr, dsHLL, t)
+	b[5] |= dsOOOFlag
+	b[6] = curMin
+	binary.LittleEndian.PutUint64(b[8:], math.Float64bits(correctedEstimate(m, kxq0+kxq1, numZeros(d))))
+	binary.LittleEndian.PutUint64(b[16:], math.Float64bits(kxq0))
+	binary.LittleEndian.PutUint64(b[24:], math.Float64bits(kxq1))
+	binary.LittleEndian.PutUint32(b[32:], uint32(numAtCurMin))
+	binary.LittleEndian.PutUint32(b[36:], uint32(len(aux)))
+	copy(b[40:], regs)
+	return b, nil
+}
+
+// dsLgAuxArrInts is the initial aux array size (log2) of an HLL_4 sketch for lgK.
+var dsLgAuxArrInts = [dsMaxLgK + 1]int{0, 2, 2, 2, 2, 2, 2, 3, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 11, 12, 13}
+
+func dsPreamble(b []byte, preInts, lgK, lgArr int, mode byte, t DataSketchesType) {
+	b[0] = byte(preInts)
+	b[1] = dsSerVer
+	b[2] = dsFamily
+	b[3] = byte(lgK)
+	b[4] = byte(lgArr)
+	b[5] = dsCompactFlag
+	b[7] = mode | byte(t)<<2
+}
+
+// numZeros returns the number of zero registers.
+func numZeros(h Dense) int {
+	V := 0
+	for i := 0; i < h.m(); i++ {
+		if h.get(i) == 0 {
+			V++
+		}
+	}
+	return V
+}
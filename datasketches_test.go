@@ -0,0 +1,220 @@
+package hll
+
+import (
+	"bytes"
+	"encoding/binary"
+	"log"
+	"math"
+	"math/bits"
+	"testing"
+)
+
+// toDenseHLL returns a dense copy of h.
+func toDenseHLL(h HLL) HLL {
+	d := make(HLL, len(h))
+	d[0] = 128 + 64 | h[0]&standardFlag
+	d.Merge(h)
+	return d
+}
+
+func TestDataSketchesRoundTrip(t *testing.T) {
+	for _, p := range []int{4, 8, 12} {
+		s, err := SizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		for _, n := range []int{0, 5, 20, 200, 5000} {
+			h := make(HLL, s)
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i + 1))
+			}
+			for _, typ := range []DataSketchesType{DataSketchesHLL4, DataSketchesHLL6, DataSketchesHLL8} {
+				b, err := EncodeDataSketches(h, typ)
+				if err != nil {
+					t.Fatal(err)
+				}
+				mode := b[7] & 3
+				switch {
+				case !h.IsSparse():
+					if mode != dsHLL {
+						t.Fatal(p, n, "dense must be in HLL mode")
+					}
+				case n < 8:
+					if mode != dsList {
+						t.Fatal(p, n, "expected LIST mode", mode)
+					}
+				case p >= 8 && n <= 24<<uint(p-8):
+					if mode != dsSet {
+						t.Fatal(p, n, "expected SET mode", mode)
+					}
+				}
+				g, gt, err := DecodeDataSketches(b)
+				if err != nil {
+					t.Fatal(p, n, typ, err)
+				}
+				if gt != typ {
+					t.Fatal(gt, typ)
+				}
+				if err := g.Validate(); err != nil {
+					t.Fatal(err)
+				}
+				if mode != dsHLL {
+					if !g.IsSparse() || g.EstimateCardinality() != h.EstimateCardinality() {
+						t.Fatal(p, n, g.EstimateCardinality(), h.EstimateCardinality())
+					}
+				}
+				if !bytes.Equal(toDenseHLL(g)[8:], toDenseHLL(h)[8:]) {
+					t.Fatal(p, n, typ, "registers differ")
+				}
+			}
+		}
+	}
+}
+
+func TestDataSketchesHLLMode(t *testing.T) {
+	const p = 10
+	s, err := DenseSizeByP(p)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s+8)
+	h[0] = 128 + 64
+	d := Dense(h[8:])
+	for i := 0; i < d.m(); i++ {
+		d.set(i, byte(3+i%5))
+	}
+	d.set(7, 30)   // Aux entry for HLL_4 (30 - 3 >= 15).
+	d.set(100, 17) // Not an aux entry (17 - 3 < 15).
+	d.set(200, 18) // Aux entry.
+	for _, typ := range []DataSketchesType{DataSketchesHLL4, DataSketchesHLL6, DataSketchesHLL8} {
+		b, err := EncodeDataSketches(h, typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b[:4], []byte{10, 1, 7, p}) || b[5] != dsCompactFlag|dsOOOFlag || b[7] != dsHLL|byte(typ)<<2 {
+			t.Fatalf("%x", b[:8])
+		}
+		var sum float64
+		for i := 0; i < d.m(); i++ {
+			sum += math.Pow(2, -float64(d.get(i)))
+		}
+		kxq0 := math.Float64frombits(binary.LittleEndian.Uint64(b[16:]))
+		kxq1 := math.Float64frombits(binary.LittleEndian.Uint64(b[24:]))
+		if math.Abs(kxq0+kxq1-sum) > 1e-9 || kxq1 != 0 {
+			t.Fatal(kxq0, kxq1, sum)
+		}
+		switch typ {
+		case DataSketchesHLL4:
+			// curMin is 3, every 5th register is at it (but 100 and 200).
+			if b[6] != 3 || binary.LittleEndian.Uint32(b[32:]) != uint32((d.m()+4)/5-2) || binary.LittleEndian.Uint32(b[36:]) != 2 {
+				t.Fatal(b[6], binary.LittleEndian.Uint32(b[32:]), binary.LittleEndian.Uint32(b[36:]))
+			}
+			if b[40+3]>>4 != dsAuxToken || b[40+50]&15 != 14 {
+				t.Fatalf("%x %x", b[43], b[90])
+			}
+			if len(b) != 40+d.m()/2+8 || binary.LittleEndian.Uint32(b[40+d.m()/2:]) != 30<<26|7 {
+				t.Fatal(len(b))
+			}
+		case DataSketchesHLL6:
+			if len(b) != 40+d.m()*3/4+1 {
+				t.Fatal(len(b))
+			}
+		case DataSketchesHLL8:
+			if len(b) != 40+d.m() || b[40+7] != 30 {
+				t.Fatal(len(b))
+			}
+		}
+		g, _, err := DecodeDataSketches(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(g[8:], h[8:]) {
+			t.Fatal(typ, "registers differ")
+		}
+	}
+}
+
+func TestDataSketchesUpdatable(t *testing.T) {
+	// A LIST with 2 coupons in an 8 slot array.
+	b := make([]byte, 8+4*8)
+	copy(b, []byte{2, 1, 7, 12, 3, 0, 2, dsList})
+	binary.LittleEndian.PutUint32(b[8+4*2:], 3<<26|12345)
+	binary.LittleEndian.PutUint32(b[8+4*5:], 1<<26|54321)
+	h, _, err := DecodeDataSketches(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h.IsSparse() || h.EstimateCardinality() != 2 {
+		t.Fatal(h.EstimateCardinality())
+	}
+	d := Dense(toDenseHLL(h)[8:])
+	if d.get(12345&4095) != 3 || d.get(54321&4095) != 1 {
+		t.Fatal(d.get(12345&4095), d.get(54321&4095))
+	}
+
+	// HLL_4 with 4 aux slots.
+	b = make([]byte, 40+8+16)
+	copy(b, []byte{10, 1, 7, 4, 2, 0, 1, dsHLL})
+	for i := 0; i < 8; i++ {
+		b[40+i] = 0x11
+	}
+	b[40] = 0xf1 // Register 1 is in aux.
+	binary.LittleEndian.PutUint32(b[48+4*3:], 40<<26|1)
+	h, _, err = DecodeDataSketches(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d = Dense(h[8:])
+	// Register 1 can not be above 63, the rest are 2.
+	for i := 0; i < 16; i++ {
+		if v := d.get(i); v != 2 && i != 1 || i == 1 && v != 40 {
+			t.Fatal(i, v)
+		}
+	}
+}
+
+func TestDataSketchesHash(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		b := []byte{byte(i), byte(i >> 8), 7}
+		h0, h1 := Murmur3(b, DataSketchesSeed)
+		lz := bits.LeadingZeros64(h1)
+		want := uint32(lz+1)<<26 | uint32(h0)&(1<<26-1)
+		if c := dsCoupon(DataSketchesHash(b)); c != want {
+			t.Fatalf("%x %x", c, want)
+		}
+	}
+}
+
+func TestDecodeDataSketchesErrors(t *testing.T) {
+	s, _ := SizeByP(10)
+	h := make(HLL, s)
+	h[0] = 128 + 64
+	Dense(h[8:]).set(3, 20)
+	good, err := EncodeDataSketches(h, DataSketchesHLL4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, b := range [][]byte{
+		nil,
+		{2, 1, 7, 12, 3, 8, 0},
+		{2, 2, 7, 12, 3, 8, 0, 0},
+		{2, 1, 8, 12, 3, 8, 0, 0},
+		{2, 1, 7, 22, 3, 8, 0, 0},
+		{2, 1, 7, 3, 3, 8, 0, 0},
+		{3, 1, 7, 12, 3, 8, 0, 0},
+		{2, 1, 7, 12, 3, 8, 1, 0},             // Missing coupon.
+		{2, 1, 7, 12, 3, 8, 1, 0, 1, 0, 0, 0}, // Zero value.
+		{2, 1, 7, 12, 3, 8, 0, 3},             // Unknown mode.
+		{2, 1, 7, 12, 3, 8, 0, 3 << 2},        // Unknown type.
+		good[:40],
+		good[:len(good)-1],
+	} {
+		if _, _, err := DecodeDataSketches(b); err == nil {
+			t.Fatal(i, "expected error")
+		}
+	}
+	s22, _ := SizeByP(22)
+	if _, err := EncodeDataSketches(make(HLL, s22), DataSketchesHLL4); err == nil {
+		t.Fatal("expected error")
+	}
+}
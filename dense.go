@@ -73,6 +73,7 @@ func (h Dense) Add(hash uint64) bool {
 	// We are using low bits of hash to get the index.
 	// We also count the number of leading zeroes in the whole hash.
 	// This skews the distribution of values somewhat, seems to have a minor effect on cardinality estimation error rate.
+	// HLL.SetStandardLayout switches to the usual layout.
 	if urho > 63 {
 		urho = 63
 	}
@@ -111,9 +112,11 @@ func (h Dense) Add(hash uint64) bool {
 }
 
 // Merge another HLL (of the same precision) into this.
+// A Dense has no header, so it does not know its layout: the caller must keep Denses filled with Add
+// and with AddStandard apart, merging them is not detected (HLL.Merge returns ErrLayoutMismatch).
 func (h Dense) Merge(g Dense) error {
 	if len(h) != len(g) {
-		return errors.New("size mismatch")
+		return ErrSizeMismatch
 	}
 	for i := 0; i < len(h); i += 3 {
 		x0, x1, x2 := h[i], h[i+1], h[i+2]
@@ -186,15 +189,23 @@ func (h Dense) set(idx int, v byte) {
 	}
 }
 
-func (h Dense) addSlow(hash uint64) {
+// register returns the register index and the value (rho) for a hash.
+func (h Dense) register(hash uint64) (int, byte) {
 	mask := uint64(h.m()) - 1
-	idx := int(hash & mask)
+	return int(hash & mask), rho(hash)
+}
+
+// rho returns the register value for a hash: number of leading zeros + 1, capped at 63.
+func rho(hash uint64) byte {
 	urho := bits.Clz(hash) + 1
 	if urho > 63 {
 		urho = 63
 	}
-	rho := byte(urho)
+	return byte(urho)
+}
 
+func (h Dense) addSlow(hash uint64) {
+	idx, rho := h.register(hash)
 	v := h.get(idx)
 	if v >= rho {
 		return
@@ -229,24 +240,16 @@ func (h Dense) EstimateCardinality() uint64 {
 		}
 	}
 
-	est := h.correctedEstimate(invSum, V)
-	card := math.Floor(est + 0.5)
-	if card > math.MaxUint64 {
-		return math.MaxUint64
-	}
-	return uint64(card)
+	return round(correctedEstimate(h.m(), invSum, V))
 }
 
-func (h *Dense) correctedEstimate(invSum float64, V int) float64 {
-	m := h.m()
+// correctedEstimate returns a cardinality estimate for m registers given the sum of 2^-register
+// and the number of zero registers, V.
+func correctedEstimate(m int, invSum float64, V int) float64 {
 	mf := float64(m)
-	e := h.alpha() * mf * mf / invSum
+	e := alpha(m) * mf * mf / invSum
 
-	var p byte
-	for z := m; z != 0; z >>= 1 {
-		p++
-	}
-	p--
+	p := byte(bits.Ctz(uint64(m)))
 	// bias
 	if e < 5*mf {
 		e -= estimateBias(e, p)
@@ -271,46 +274,20 @@ func init() {
 	}
 }
 
+//go:generate go run ./internal/gentables
+
+// threshold returns the largest linear counting estimate to use for m registers.
+// Those thresholds are not from the original article: internal/gentables tunes them by simulation.
 func threshold(m int) float64 {
-	// Those thresholds are not from the original article.
-	switch m {
-	case 1 << 4:
-		return 13
-	case 1 << 5:
-		return 40
-	case 1 << 6:
-		return 70
-	case 1 << 7:
-		return 180
-	case 1 << 8:
-		return 225
-	case 1 << 9:
-		return 1000
-	case 1 << 10:
-		return 1750
-	case 1 << 11:
-		return 4600
-	case 1 << 12:
-		return 10 * 1000
-	case 1 << 13:
-		return 22 * 1000
-	case 1 << 14:
-		return 45 * 1000
-	case 1 << 15:
-		return 80 * 1000
-	case 1 << 16:
-		return 150 * 1000
-	case 1 << 17:
-		return 400 * 1000
-	case 1 << 18:
-		return 700 * 1000
-	case 1 << 19:
-		return 1850 * 1000
-	case 1 << 20:
-		return 4200 * 1000
-	default:
-		return float64(m) * 8
+	return thresholdData[bits.Ctz(uint64(m))-4]
+}
+
+func round(x float64) uint64 {
+	x = math.Floor(x + 0.5)
+	if x > math.MaxUint64 {
+		return math.MaxUint64
 	}
+	return uint64(x)
 }
 
 // linearCounting performs linear counting given the number of registers, m1, and the number
@@ -322,28 +299,21 @@ func linearCounting(m int, V int) float64 {
 // estimateBias estimates the amount of bias in cardinality
 // with an estimator value of e and precision p.
 func estimateBias(x float64, p byte) float64 {
-	if p > 18 {
-		return 0.0
-	}
 	estimateVector := rawEstimateData[p-4]
 	N := len(estimateVector)
 	if x < estimateVector[0] || x > estimateVector[N-1] {
 		return 0.0
 	}
 	biasV := biasData[p-4]
-	// rawEstimates are almost monotone -- there are a few small inversions for p=2 and p=3.
-	// It does not matter much.
-	for i := 0; i < len(estimateVector)-1; i++ {
-		a := estimateVector[i]
-		if x == a {
-			return biasV[i]
-		}
-		if x > a {
+	// Interpolate between the raw estimates around x (they are increasing).
+	for i := 1; i < N; i++ {
+		b := estimateVector[i]
+		if x > b {
 			continue
 		}
-		b := estimateVector[i+1]
-		vb := biasV[i+1]
-		va := biasV[i]
+		a := estimateVector[i-1]
+		vb := biasV[i]
+		va := biasV[i-1]
 		r := (x-a)*vb + (b-x)*va
 		return r / (b - a)
 	}
@@ -354,8 +324,12 @@ func (h Dense) m() int {
 	return (len(h) / 3) << 2
 }
 
-func (h Dense) alpha() float64 {
-	m := h.m()
+// p returns the precision, log2(m).
+func (h Dense) p() byte {
+	return byte(bits.Ctz(uint64(h.m())))
+}
+
+func alpha(m int) float64 {
 	switch m {
 	case 16:
 		return 0.673
@@ -0,0 +1,140 @@
+package hll
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// HashID identifies the hash function that fed an HLL.
+type HashID uint8
+
+const (
+	// HashUnknown is for hashes not known to this package.
+	HashUnknown HashID = 0
+	// HashXXHash64 is XXHash64. AddBytes, AddString and AddUint64 use it with seed 0.
+	HashXXHash64 HashID = 1
+	// HashMurmur3 is the first half of Murmur3 (x64, 128 bit).
+	HashMurmur3 HashID = 2
+)
+
+// EnvelopeVersion is the envelope layout version written by Encode.
+const EnvelopeVersion = 1
+
+// EnvelopeSize is the byte size of the envelope that precedes an encoded HLL.
+const EnvelopeSize = 16
+
+var envelopeMagic = []byte("GHLL")
+
+var (
+	// ErrHashMismatch is returned when merging sketches fed by different hash functions (or seeds).
+	ErrHashMismatch = errors.New("hash function mismatch")
+	// ErrVersionMismatch is returned for envelopes of a version other than EnvelopeVersion.
+	ErrVersionMismatch = errors.New("envelope version mismatch")
+)
+
+// Envelope makes an HLL self-describing: it records the layout version, the hash function and the precision.
+//
+// Layout (16 bytes, followed by the HLL):
+//
+//	4 bytes: magic "GHLL".
+//	1 byte: version.
+//	1 byte: hash function (HashID).
+//	1 byte: precision.
+//	1 byte: reserved, 0.
+//	8 bytes: hash seed (big endian).
+//
+// Like HLL, an encoded HLL is byte buffer friendly: Decode does not copy.
+type Envelope struct {
+	Version uint8
+	Hash    HashID
+	Seed    uint64
+	P       uint8
+}
+
+// Encode returns the envelope followed by a copy of h.
+// The version and the precision are filled in; if e.P is set, it must match h.
+func Encode(e Envelope, h HLL) ([]byte, error) {
+	if err := h.IsValid(); err != nil {
+		return nil, err
+	}
+	p := Dense(h[8:]).p()
+	if e.P != 0 && e.P != p {
+		return nil, errors.New("precision mismatch")
+	}
+	b := make([]byte, EnvelopeSize+len(h))
+	copy(b, envelopeMagic)
+	b[4] = EnvelopeVersion
+	b[5] = byte(e.Hash)
+	b[6] = p
+	binary.BigEndian.PutUint64(b[8:], e.Seed)
+	copy(b[EnvelopeSize:], h)
+	return b, nil
+}
+
+// Decode parses an encoded HLL.
+// The returned HLL is a part of b (no copy is made), so it can be modified in place.
+func Decode(b []byte) (Envelope, HLL, error) {
+	if len(b) < EnvelopeSize {
+		return Envelope{}, nil, errors.New("size too small")
+	}
+	if !bytes.Equal(b[:4], envelopeMagic) {
+		return Envelope{}, nil, errors.New("bad magic")
+	}
+	e := Envelope{
+		Version: b[4],
+		Hash:    HashID(b[5]),
+		P:       b[6],
+		Seed:    binary.BigEndian.Uint64(b[8:]),
+	}
+	if e.Version != EnvelopeVersion {
+		return e, nil, ErrVersionMismatch
+	}
+	if b[7] != 0 {
+		return e, nil, errors.New("reserved envelope byte is set")
+	}
+	s, err := SizeByP(int(e.P))
+	if err != nil {
+		return e, nil, err
+	}
+	if len(b) != EnvelopeSize+s {
+		return e, nil, errors.New("size does not match precision")
+	}
+	h := HLL(b[EnvelopeSize:])
+	if err := h.IsValid(); err != nil {
+		return e, nil, err
+	}
+	return e, h, nil
+}
+
+// Compatible returns an error if sketches with envelopes e and o can not be merged.
+// Both must be of EnvelopeVersion (as Decode returns them).
+func (e Envelope) Compatible(o Envelope) error {
+	if e.Version != EnvelopeVersion || o.Version != EnvelopeVersion {
+		return ErrVersionMismatch
+	}
+	if e.Hash != o.Hash || e.Seed != o.Seed {
+		return ErrHashMismatch
+	}
+	if e.P != o.P {
+		return ErrSizeMismatch
+	}
+	return nil
+}
+
+// MergeEncoded merges encoded src into encoded dst, in place.
+// Refuses to merge sketches with unsupported versions, different hash functions or seeds.
+func MergeEncoded(dst, src []byte) error {
+	de, dh, err := Decode(dst)
+	if err != nil {
+		return err
+	}
+	se, sh, err := Decode(src)
+	if err != nil {
+		return err
+	}
+	if err := de.Compatible(se); err != nil {
+		return err
+	}
+	return dh.Merge(sh)
+}
@@ -0,0 +1,99 @@
+package hll
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	s, _ := SizeByP(10)
+	h := make(HLL, s)
+	for i := 0; i < 100; i++ {
+		h.AddUint64(uint64(i))
+	}
+	b, err := Encode(Envelope{Hash: HashXXHash64}, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, d, err := Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e != (Envelope{Version: EnvelopeVersion, Hash: HashXXHash64, P: 10}) {
+		t.Fatal(e)
+	}
+	if !bytes.Equal(d, h) {
+		t.Fatal("hll mismatch")
+	}
+	// Decode does not copy.
+	d.AddUint64(1000)
+	if _, d2, _ := Decode(b); d2.EstimateCardinality() != 101 {
+		t.Fatal(d2.EstimateCardinality())
+	}
+
+	if _, err := Encode(Envelope{P: 11}, h); err == nil {
+		t.Fatal("expected error")
+	}
+	for _, corrupt := range []func([]byte) []byte{
+		func(b []byte) []byte { return b[:EnvelopeSize-1] },
+		func(b []byte) []byte { b[0] = 'X'; return b },
+		func(b []byte) []byte { b[4] = 2; return b },
+		func(b []byte) []byte { b[6] = 11; return b },
+		func(b []byte) []byte { b[6] = 30; return b },
+		func(b []byte) []byte { b[7] = 1; return b },
+		func(b []byte) []byte { return b[:len(b)-1] },
+	} {
+		if _, _, err := Decode(corrupt(append([]byte(nil), b...))); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+}
+
+func TestMergeEncoded(t *testing.T) {
+	s, _ := SizeByP(10)
+	h := make(HLL, s)
+	h.AddString("alpha")
+	g := make(HLL, s)
+	g.AddString("beta")
+	a, _ := Encode(Envelope{Hash: HashXXHash64}, h)
+	b, _ := Encode(Envelope{Hash: HashXXHash64}, g)
+	if err := MergeEncoded(a, b); err != nil {
+		t.Fatal(err)
+	}
+	if _, m, _ := Decode(a); m.EstimateCardinality() != 2 {
+		t.Fatal(m.EstimateCardinality())
+	}
+
+	c, _ := Encode(Envelope{Hash: HashMurmur3}, g)
+	if err := MergeEncoded(a, c); err != ErrHashMismatch {
+		t.Fatal(err)
+	}
+	c, _ = Encode(Envelope{Hash: HashXXHash64, Seed: 1}, g)
+	if err := MergeEncoded(a, c); err != ErrHashMismatch {
+		t.Fatal(err)
+	}
+	s, _ = SizeByP(11)
+	c, _ = Encode(Envelope{Hash: HashXXHash64}, make(HLL, s))
+	if err := MergeEncoded(a, c); err == nil {
+		t.Fatal("expected error")
+	}
+	// Decode rejects other versions, so MergeEncoded never merges them.
+	c, _ = Encode(Envelope{Hash: HashXXHash64}, g)
+	c[4] = EnvelopeVersion + 1
+	if err := MergeEncoded(a, c); err != ErrVersionMismatch {
+		t.Fatal("expected version mismatch", err)
+	}
+	if err := MergeEncoded(c, a); err != ErrVersionMismatch {
+		t.Fatal("expected version mismatch", err)
+	}
+	if _, _, err := Decode(c); err != ErrVersionMismatch {
+		t.Fatal("expected version mismatch", err)
+	}
+	v := Envelope{Version: EnvelopeVersion}
+	if v.Compatible(Envelope{Version: EnvelopeVersion + 1}) != ErrVersionMismatch || (Envelope{Version: EnvelopeVersion + 1}).Compatible(v) != ErrVersionMismatch {
+		t.Fatal("expected version mismatch")
+	}
+	if v.Compatible(v) != nil {
+		t.Fatal("expected compatible")
+	}
+}
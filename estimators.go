@@ -0,0 +1,202 @@
+package hll
+
+import (
+	"math"
+)
+
+// Estimator selects how EstimateWith turns the registers into a cardinality estimate.
+// All of them start from the register histogram (the number of registers with each value).
+type Estimator int
+
+const (
+	// EstimatorClassic is the EstimateCardinality estimate: HLL++ bias correction and linear counting
+	// below a threshold (tables from internal/gentables).
+	EstimatorClassic Estimator = iota
+	// EstimatorLogLogBeta is LogLog-Beta ("LogLog-Beta and More" by Jason Qin et al., https://arxiv.org/abs/1612.02284):
+	// one formula for all cardinalities, with a correction term (beta) fitted for every p (see internal/gentables).
+	// Eight coefficients only go so far: the bias grows with p, to about 2 standard errors at p = 25.
+	EstimatorLogLogBeta
+	// EstimatorErtlImproved is the improved raw estimator (see "New cardinality estimation algorithms
+	// for HyperLogLog sketches" by Otmar Ertl, https://arxiv.org/abs/1702.01284, section 3).
+	// No tables or thresholds, nearly unbiased for all cardinalities.
+	EstimatorErtlImproved
+	// EstimatorMLE is the maximum likelihood estimate (Ertl, section 4). Slightly more accurate than EstimatorErtlImproved.
+	EstimatorMLE
+)
+
+// EstimateWith returns a cardinality estimate computed with e. Never modifies the HLL.
+// Unknown estimators fall back to EstimatorClassic.
+func (h Dense) EstimateWith(e Estimator) uint64 {
+	var f func(c *[maxRho + 1]uint32, p byte) float64
+	switch e {
+	case EstimatorLogLogBeta:
+		f = logLogBetaEstimate
+	case EstimatorErtlImproved:
+		f = ertlImprovedEstimate
+	case EstimatorMLE:
+		f = mleEstimate
+	default:
+		return h.EstimateCardinality()
+	}
+	c := h.RegisterHistogram()
+	return round(f(&c, h.p()))
+}
+
+// EstimateWith returns a cardinality estimate computed with e. Never modifies the HLL.
+// A sparse HLL does not have registers: it returns the same as PeekCardinality, whatever the estimator.
+// A dense one does not use (or update) the cached estimate, so the cost is a scan of the registers.
+func (h HLL) EstimateWith(e Estimator) uint64 {
+	if h[0]&(1<<6) == 0 {
+		return h.PeekCardinality()
+	}
+	return Dense(h[8:]).EstimateWith(e)
+}
+
+// topRegister returns q: register values 1...q follow the geometric distribution, P(register > q) is 2^-q.
+// Larger values are taken as q+1 by the estimators below.
+// The index uses the low p bits of a hash, the leading zeros of the other 64-p bits are counted exactly;
+// beyond that they run into the index bits.
+func topRegister(p byte) int {
+	return 64 - int(p)
+}
+
+// collapse returns the histogram with all the values above q counted as q+1.
+func collapse(c *[maxRho + 1]uint32, q int) (r [maxRho + 2]float64) {
+	for k, n := range c {
+		if k > q {
+			k = q + 1
+		}
+		r[k] += float64(n)
+	}
+	return r
+}
+
+// ertlImprovedEstimate (see Ertl, algorithm 6).
+// It uses alpha(m), as the classic estimate does, rather than its limit 1/(2 ln 2): that removes most of the bias for small m.
+func ertlImprovedEstimate(c *[maxRho + 1]uint32, p byte) float64 {
+	q := topRegister(p)
+	r := collapse(c, q)
+	m := float64(int(1) << p)
+	z := m * ertlTau(1-r[q+1]/m)
+	for k := q; k >= 1; k-- {
+		z = 0.5 * (z + r[k])
+	}
+	z += m * ertlSigma(r[0]/m)
+	return alpha(int(m)) * m * m / z
+}
+
+// ertlSigma is x + sum(x^(2^k) * 2^(k-1)), k >= 1.
+func ertlSigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+	y := 1.0
+	z := x
+	for {
+		x *= x
+		prev := z
+		z += x * y
+		y += y
+		if z == prev {
+			return z
+		}
+	}
+}
+
+// ertlTau is (1 - x - sum((1 - x^(2^-k))^2 * 2^-k)) / 3, k >= 1.
+func ertlTau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y := 1.0
+	z := 1 - x
+	for {
+		x = math.Sqrt(x)
+		prev := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+		if z == prev {
+			return z / 3
+		}
+	}
+}
+
+// mleEstimate maximizes the likelihood of the histogram over the Poisson rate λ (see Ertl, section 4):
+// P(register <= k) = exp(-λ/m * 2^-k) for k <= q, P(register <= q+1) = 1.
+// With x = λ/m and φ(t) = t / (e^t - 1) the maximum is the root of the decreasing convex function
+// f(x) = sum(c[k] * φ(x * 2^-k), 1 <= k <= q) + c[q+1] * φ(x * 2^-q) - x * (c[0] + sum(c[k] * 2^-k, 1 <= k <= q)).
+// Newton's method converges to it monotonically from below.
+// The result is scaled by 2 ln 2 * alpha(m) (see ertlImprovedEstimate).
+func mleEstimate(c *[maxRho + 1]uint32, p byte) float64 {
+	q := topRegister(p)
+	r := collapse(c, q)
+	m := float64(int(1) << p)
+	if r[0] == m {
+		return 0
+	}
+	a, b := r[0], r[q+1]*math.Ldexp(1, -q)
+	for k := 1; k <= q; k++ {
+		a += r[k] * math.Ldexp(1, -k)
+		b += r[k] * math.Ldexp(1, -k)
+	}
+	if a == 0 {
+		return math.Inf(1) // All the registers are saturated.
+	}
+	// φ(t) >= 1 - t/2, so f(x) >= 0 here.
+	x := (m - r[0]) / (a + b/2)
+	for i := 0; i < 100; i++ {
+		f, df := -x*a, -a
+		for k := 1; k <= q+1; k++ {
+			if r[k] == 0 {
+				continue
+			}
+			s := math.Ldexp(1, -k)
+			if k > q {
+				s = math.Ldexp(1, -q)
+			}
+			phi, dphi := mlePhi(x * s)
+			f += r[k] * phi
+			df += r[k] * s * dphi
+		}
+		dx := -f / df
+		x += dx
+		if dx <= x*1e-12 {
+			break
+		}
+	}
+	return 2 * math.Ln2 * alpha(int(m)) * m * x
+}
+
+// mlePhi returns t / (e^t - 1) and its derivative.
+func mlePhi(t float64) (float64, float64) {
+	if t < 1e-5 {
+		return 1 - t/2, -0.5 + t/6
+	}
+	if t > 50 {
+		e := math.Exp(-t)
+		return t * e, (1 - t) * e
+	}
+	d := math.Expm1(t)
+	return t / d, (d - t*(d+1)) / (d * d)
+}
+
+// logLogBetaEstimate is alpha * m * (m - z) / (beta(z) + sum(2^-register)), z being the number of zero registers,
+// beta(z) = b0 * z + b1 * zl + b2 * zl^2 + ... + b7 * zl^7, zl = log(z + 1).
+func logLogBetaEstimate(c *[maxRho + 1]uint32, p byte) float64 {
+	m := int(1) << p
+	z := float64(c[0])
+	var invSum float64
+	for k, n := range c {
+		invSum += float64(n) * lookup[k]
+	}
+	b := &logLogBetaData[p-4]
+	zl := math.Log(z + 1)
+	beta := b[0] * z
+	x := 1.0
+	for _, bk := range b[1:] {
+		x *= zl
+		beta += bk * x
+	}
+	mf := float64(m)
+	return alpha(m) * mf * (mf - z) / (beta + invSum)
+}
@@ -0,0 +1,143 @@
+package hll
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var estimators = []Estimator{EstimatorClassic, EstimatorLogLogBeta, EstimatorErtlImproved, EstimatorMLE}
+
+func TestEstimateWithEmpty(t *testing.T) {
+	for _, p := range []int{4, 14, 25} {
+		s, err := DenseSizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		h := make(Dense, s)
+		for _, e := range estimators {
+			if c := h.EstimateWith(e); c != 0 {
+				t.Fatal(p, e, c)
+			}
+		}
+		// Saturated: every register is at the maximum.
+		for i := 0; i < h.m(); i++ {
+			h.set(i, maxRho)
+		}
+		for _, e := range estimators[1:] {
+			if c := h.EstimateWith(e); c < 1<<62 {
+				t.Fatal(p, e, c)
+			}
+		}
+	}
+}
+
+func TestEstimateWithHLL(t *testing.T) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	for i := 0; i < 100; i++ {
+		h.Add(xxHash64Uint64(uint64(i)))
+	}
+	for _, e := range estimators {
+		if c := h.EstimateWith(e); c != 100 {
+			t.Fatal("sparse should be exact", e, c)
+		}
+	}
+	for i := 100; i < 10000; i++ {
+		h.Add(xxHash64Uint64(uint64(i)))
+	}
+	if h.IsSparse() {
+		t.Fatal("should be dense")
+	}
+	dirty := append(HLL(nil), h...)
+	for _, e := range estimators {
+		if c, want := h.EstimateWith(e), Dense(h[8:]).EstimateWith(e); c != want {
+			t.Fatal(e, c, want)
+		}
+	}
+	if string(h) != string(dirty) {
+		t.Fatal("EstimateWith should not modify the HLL")
+	}
+	if h.EstimateWith(EstimatorClassic) != h.EstimateCardinality() {
+		t.Fatal("classic should be EstimateCardinality")
+	}
+}
+
+// TestEstimatorAccuracy checks bias and error of the estimators across cardinalities,
+// including the range where the classic one switches from linear counting.
+func TestEstimatorAccuracy(t *testing.T) {
+	for _, c := range []struct {
+		p, trials int
+	}{
+		{4, 2000},
+		{6, 1000},
+		{8, 300},
+		{12, 40},
+		{20, 2},
+		{25, 1},
+	} {
+		s, err := DenseSizeByP(c.p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		h := make(Dense, s)
+		m := h.m()
+		stdErr := 1.04 / math.Sqrt(float64(m))
+		// Cardinalities (as multiples of m) to check, the last one well above the linear counting range.
+		checkpoints := []float64{0.5, 1, 2, 3, 5, 8, 20}
+		if c.p == 20 {
+			checkpoints = []float64{0.1, 1, 3, 5}
+		}
+		if c.p == 25 {
+			checkpoints = []float64{0.1, 1, 3}
+		}
+		checked := estimators
+		var sum, sum2 [4][8]float64
+		rnd := rand.New(rand.NewSource(int64(c.p)))
+		for k := 0; k < c.trials; k++ {
+			h.Clear()
+			n := 0
+			for j, x := range checkpoints {
+				for ; float64(n) < x*float64(m); n++ {
+					h.Add(xxHash64Uint64(rnd.Uint64()))
+				}
+				for i, e := range checked {
+					d := float64(h.EstimateWith(e))/float64(n) - 1
+					sum[i][j] += d
+					sum2[i][j] += d * d
+				}
+			}
+		}
+		for i, e := range checked {
+			for j, x := range checkpoints {
+				bias := sum[i][j] / float64(c.trials)
+				rmse := math.Sqrt(sum2[i][j] / float64(c.trials))
+				// The bias check allows for the noise of the trials, and a bias of order 1/m, noticeable for small p.
+				// A single trial is only checked against the bias bound: 3 standard errors.
+				if math.Abs(bias) > 3*stdErr/math.Sqrt(float64(c.trials))+1/float64(m) || c.trials > 1 && rmse > 1.2*stdErr {
+					t.Error(c.p, e, x, bias, rmse, stdErr)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkEstimateWith(b *testing.B) {
+	s, _ := DenseSizeByP(14)
+	h := make(Dense, s)
+	for i := 0; i < 50000; i++ {
+		h.Add(xxHash64Uint64(uint64(i)))
+	}
+	for _, e := range estimators {
+		e := e
+		b.Run([]string{"Classic", "LogLogBeta", "ErtlImproved", "MLE"}[e], func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				h.EstimateWith(e)
+			}
+		})
+	}
+}
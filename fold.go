@@ -0,0 +1,151 @@
+package hll
+
+import "errors"
+
+// Fold downsamples the HLL to a lower precision p, in place.
+// Returns the shortened HLL (a prefix of h); the rest of h is left as is.
+//
+// Register values do not depend on the precision (index comes from the low bits of the hash),
+// so folding is exact: the result is the same as if the hashes were added to an HLL of precision p.
+// That holds for the standard layout as well (see SetStandardLayout), where the dropped index bits count towards rho.
+// A sparse HLL stays sparse if its hashes fit, otherwise it is converted to dense
+// (might allocate a block with Alloc).
+func (h HLL) Fold(p int) (HLL, error) {
+	n, err := SizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	if n > len(h) {
+		return nil, errors.New("can not fold into a higher precision")
+	}
+	if h[0]&(1<<6) != 0 {
+		fold := Dense(h[8:]).Fold
+		if h.IsStandardLayout() {
+			fold = Dense(h[8:]).foldStandard
+		}
+		if _, err := fold(p); err != nil {
+			return nil, err
+		}
+		h[0] |= 1 << 7 // Mark as dirty.
+		return h[:n], nil
+	}
+	s := sparse(h)
+	if s.compressed() {
+		// Entries do not depend on the precision: the list just needs to fit (without the tail, that is at the end).
+		if compressed(s).flush() == ok && 8+compressed(s).listSize() <= n {
+			return h[:n], nil
+		}
+	} else {
+		if 8+8*int(s.size()) > n && s.dirty() {
+			s.sort() // Removing duplicates might be enough.
+		}
+		if 8+8*int(s.size()) <= n {
+			return h[:n], nil
+		}
+	}
+	tmp := Dense(Alloc(n - 8))
+	mergeIntoDense(tmp, s)
+	copy(h[8:], tmp)
+	Free(tmp)
+	h[0] = 128 + 64 | h[0]&standardFlag // dirty + dense, same layout
+	return h[:n], nil
+}
+
+// MergeFolding merges g into h. Unlike Merge, the precisions might differ: the result has the lower of the two.
+// If h has the higher precision it is folded in place and the shortened HLL is returned.
+// g is never modified.
+func (h HLL) MergeFolding(g HLL) (HLL, error) {
+	if (h[0]^g[0])&standardFlag != 0 {
+		return nil, ErrLayoutMismatch
+	}
+	if len(g) < len(h) {
+		var err error
+		if h, err = h.Fold(int(Dense(g[8:]).p())); err != nil {
+			return nil, err
+		}
+	}
+	if len(h) == len(g) {
+		return h, h.Merge(g)
+	}
+	// g has a higher precision.
+	if g[0]&(1<<6) == 0 {
+		// Sparse hashes can be added at any precision.
+		if h[0]&(1<<6) != 0 {
+			mergeIntoDense(Dense(h[8:]), sparse(g))
+			h[0] |= 128
+			return h, nil
+		}
+		if mergeIntoSparse(sparse(h), sparse(g)) == ok {
+			return h, nil
+		}
+		toDense(sparse(h))
+		mergeIntoDense(Dense(h[8:]), sparse(g))
+		return h, nil
+	}
+	if h[0]&(1<<6) == 0 {
+		toDense(sparse(h))
+	}
+	if h.IsStandardLayout() {
+		Dense(h[8:]).mergeFoldedStandard(Dense(g[8:]))
+	} else {
+		Dense(h[8:]).mergeFolded(Dense(g[8:]))
+	}
+	h[0] |= 128
+	return h, nil
+}
+
+// Fold downsamples the HLL to a lower precision p, in place.
+// Returns the shortened HLL (a prefix of h).
+// The result is the same as if the hashes were added to a Dense of precision p.
+func (h Dense) Fold(p int) (Dense, error) {
+	n, err := DenseSizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	if n > len(h) {
+		return nil, errors.New("can not fold into a higher precision")
+	}
+	m := n / 3 << 2
+	M := h.m()
+	// Register i of the result is the max of registers i, i+m, i+2m... of h.
+	// Registers are processed in order, so set(i) only overwrites a register that is not read anymore.
+	for i := 0; i < m; i++ {
+		v := h.get(i)
+		for j := i + m; j < M; j += m {
+			if x := h.get(j); x > v {
+				v = x
+			}
+		}
+		h.set(i, v)
+	}
+	return h[:n], nil
+}
+
+// MergeFolding merges g into h. Unlike Merge, the precisions might differ: the result has the lower of the two.
+// If h has the higher precision it is folded in place and the shortened HLL is returned.
+// g is never modified.
+func (h Dense) MergeFolding(g Dense) (Dense, error) {
+	if len(g) < len(h) {
+		var err error
+		if h, err = h.Fold(int(g.p())); err != nil {
+			return nil, err
+		}
+	}
+	if len(h) == len(g) {
+		return h, h.Merge(g)
+	}
+	h.mergeFolded(g)
+	return h, nil
+}
+
+// mergeFolded merges g (of a higher precision) into h.
+func (h Dense) mergeFolded(g Dense) {
+	mask := h.m() - 1
+	M := g.m()
+	for i := 0; i < M; i++ {
+		v := g.get(i)
+		if v > h.get(i&mask) {
+			h.set(i&mask, v)
+		}
+	}
+}
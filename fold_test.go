@@ -0,0 +1,146 @@
+package hll
+
+import (
+	"bytes"
+	"log"
+	"testing"
+)
+
+func TestFoldDense(t *testing.T) {
+	for _, p := range []int{4, 9, 16} {
+		s, err := DenseSizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		h := make(Dense, s)
+		for i := 0; i < 100000; i++ {
+			h.Add(xorShift64StarRound(i))
+		}
+		for q := 4; q <= p; q++ {
+			s, err := DenseSizeByP(q)
+			if err != nil {
+				log.Panicln(err)
+			}
+			expected := make(Dense, s)
+			for i := 0; i < 100000; i++ {
+				expected.Add(xorShift64StarRound(i))
+			}
+			f, err := append(Dense(nil), h...).Fold(q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(f, expected) {
+				t.Fatalf("p: %d q: %d folded registers differ", p, q)
+			}
+		}
+	}
+	s, _ := DenseSizeByP(8)
+	if _, err := make(Dense, s).Fold(9); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFoldHLL(t *testing.T) {
+	for _, n := range []int{0, 10, 100, 1000, 100000} {
+		for _, sd := range []byte{0, 64} {
+			s, _ := SizeByP(14)
+			h := make(HLL, s)
+			h[0] = sd
+			s, _ = SizeByP(8)
+			expected := make(HLL, s)
+			expected[0] = sd
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i))
+				expected.Add(xorShift64StarRound(i))
+			}
+			f, err := h.Fold(8)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(f) != len(expected) {
+				t.Fatal(len(f), len(expected))
+			}
+			if f.IsSparse() != expected.IsSparse() {
+				t.Fatal(n, sd, f.IsSparse(), expected.IsSparse())
+			}
+			if f.EstimateCardinality() != expected.EstimateCardinality() {
+				t.Fatal(n, sd, f.EstimateCardinality(), expected.EstimateCardinality())
+			}
+			if !f.IsSparse() && !bytes.Equal(f[8:], expected[8:]) {
+				t.Fatal(n, sd, "folded registers differ")
+			}
+		}
+	}
+}
+
+func TestMergeFolding(t *testing.T) {
+	for _, n := range []int{3, 100, 1000, 100000} {
+		for _, hd := range []byte{0, 64} {
+			for _, gd := range []byte{0, 64} {
+				for _, ps := range [][2]int{{12, 8}, {8, 12}, {10, 10}} {
+					s, _ := SizeByP(ps[0])
+					h := make(HLL, s)
+					h[0] = hd
+					s, _ = SizeByP(ps[1])
+					g := make(HLL, s)
+					g[0] = gd
+					s, _ = SizeByP(8)
+					if ps[0] == ps[1] {
+						s, _ = SizeByP(ps[0])
+					}
+					all := make(HLL, s)
+					all[0] = 64
+					for i := 0; i < n; i++ {
+						h.Add(xorShift64StarRound(i))
+						g.Add(xorShift64StarRound(i + n/2))
+						all.Add(xorShift64StarRound(i))
+						all.Add(xorShift64StarRound(i + n/2))
+					}
+					g0 := append(HLL(nil), g...)
+					m, err := h.MergeFolding(g)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !bytes.Equal(g, g0) {
+						t.Fatal("g modified")
+					}
+					if len(m) != len(all) {
+						t.Fatal(len(m), len(all))
+					}
+					if m.IsSparse() {
+						if m.EstimateCardinality() != uint64(n+n/2) {
+							t.Fatal(n, hd, gd, ps, m.EstimateCardinality())
+						}
+						continue
+					}
+					if !bytes.Equal(m[8:], all[8:]) {
+						t.Fatal(n, hd, gd, ps, "merged registers differ")
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestMergeFoldingDense(t *testing.T) {
+	s8, _ := DenseSizeByP(8)
+	s12, _ := DenseSizeByP(12)
+	for _, sizes := range [][2]int{{s8, s12}, {s12, s8}} {
+		h := make(Dense, sizes[0])
+		g := make(Dense, sizes[1])
+		all := make(Dense, s8)
+		for i := 0; i < 10000; i++ {
+			h.Add(xorShift64StarRound(i))
+			g.Add(xorShift64StarRound(i * 3))
+			all.Add(xorShift64StarRound(i))
+			all.Add(xorShift64StarRound(i * 3))
+		}
+		m, err := h.MergeFolding(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(m, all) {
+			t.Fatal("merged registers differ")
+		}
+	}
+}
@@ -0,0 +1,178 @@
+//go:build go1.18
+
+package hll
+
+import (
+	"log"
+	"testing"
+)
+
+// fuzzSeeds returns a few small (p = 4) HLLs: empty, sparse (clean and dirty, plain and compressed) and dense,
+// in both layouts.
+func fuzzSeeds() []HLL {
+	s, err := SizeByP(4)
+	if err != nil {
+		log.Panicln(err)
+	}
+	var seeds []HLL
+	for _, n := range []int{0, 1, 3, 100} {
+		h := make(HLL, s)
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+		}
+		seeds = append(seeds, append(HLL(nil), h...))
+		h.EstimateCardinality()
+		seeds = append(seeds, h)
+	}
+	h := make(HLL, s)
+	h.CompressSparse()
+	h.Add(xorShift64StarRound(1))
+	seeds = append(seeds, append(HLL(nil), h...))
+	h.EstimateCardinality()
+	seeds = append(seeds, h)
+	for _, n := range []int{3, 100} {
+		h := make(HLL, s)
+		h.SetStandardLayout()
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+		}
+		seeds = append(seeds, h)
+	}
+	return seeds
+}
+
+// exercise runs all the operations that must not panic on a valid HLL.
+func exercise(t *testing.T, h HLL) {
+	h.PeekCardinality()
+	h.EstimateCardinality()
+	h.Add(0x0123456789abcdef)
+	h.Add(1)
+	if err := h.Validate(); err != nil {
+		t.Fatal("Add broke a valid hll:", err)
+	}
+	h.EstimateCardinality()
+	if err := h.Validate(); err != nil {
+		t.Fatal("EstimateCardinality broke a valid hll:", err)
+	}
+}
+
+func FuzzSafeEstimate(f *testing.F) {
+	for _, h := range fuzzSeeds() {
+		f.Add([]byte(h))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		h := HLL(b)
+		if _, err := h.SafeEstimate(); err != nil {
+			return
+		}
+		exercise(t, h)
+		if !h.IsSparse() {
+			if _, err := Dense(h[8:]).SafeEstimate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func FuzzSafeMerge(f *testing.F) {
+	seeds := fuzzSeeds()
+	for _, a := range seeds {
+		for _, b := range seeds {
+			f.Add([]byte(a), []byte(b))
+		}
+	}
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		// The fuzzer might pass overlapping slices.
+		h, g := HLL(append([]byte(nil), a...)), HLL(append([]byte(nil), b...))
+		if err := h.SafeMerge(g); err != nil {
+			return
+		}
+		if err := h.Validate(); err != nil {
+			t.Fatal("Merge broke a valid hll:", err)
+		}
+		exercise(t, h)
+		if !h.IsSparse() && !g.IsSparse() {
+			if err := Dense(h[8:]).SafeMerge(Dense(g[8:])); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func FuzzDecodeDataSketches(f *testing.F) {
+	for _, h := range fuzzSeeds() {
+		if h.IsStandardLayout() {
+			continue
+		}
+		for _, typ := range []DataSketchesType{DataSketchesHLL4, DataSketchesHLL6, DataSketchesHLL8} {
+			b, err := EncodeDataSketches(h, typ)
+			if err != nil {
+				f.Fatal(err)
+			}
+			f.Add(b)
+		}
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		h, _, err := DecodeDataSketches(b)
+		if err != nil {
+			return
+		}
+		if err := h.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzDecodeZeta(f *testing.F) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	for _, n := range []int{0, 3, 100, 10000} {
+		h := make(HLL, s)
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+		}
+		b, err := EncodeZeta(h, ZetaParams{})
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		h, _, err := DecodeZeta(b)
+		if err != nil {
+			return
+		}
+		if err := h.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzDecodeAirlift(f *testing.F) {
+	s, err := SizeByP(11)
+	if err != nil {
+		log.Panicln(err)
+	}
+	for _, n := range []int{0, 3, 100, 10000} {
+		h := make(HLL, s)
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+		}
+		b, err := EncodeAirlift(h)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		h, err := DecodeAirlift(b)
+		if err != nil {
+			return
+		}
+		if err := h.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
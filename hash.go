@@ -0,0 +1,194 @@
+package hll
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Built-in hash functions.
+//
+// AddBytes, AddString and AddUint64 always use XXHash64 with seed 0.
+// This choice is a part of the format and will not change:
+// sketches built with those methods in different processes (or by different versions of this package) can be merged.
+// Use Add directly to feed any other hash.
+
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+// XXHash64 returns the 64-bit xxHash (XXH64) of b.
+func XXHash64(b []byte, seed uint64) uint64 {
+	n := len(b)
+	var h uint64
+	if n >= 32 {
+		v1 := seed + xxPrime1 + xxPrime2
+		v2 := seed + xxPrime2
+		v3 := seed
+		v4 := seed - xxPrime1
+		for ; len(b) >= 32; b = b[32:] {
+			v1 = xxRound(v1, binary.LittleEndian.Uint64(b))
+			v2 = xxRound(v2, binary.LittleEndian.Uint64(b[8:]))
+			v3 = xxRound(v3, binary.LittleEndian.Uint64(b[16:]))
+			v4 = xxRound(v4, binary.LittleEndian.Uint64(b[24:]))
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) + bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxMergeRound(h, v1)
+		h = xxMergeRound(h, v2)
+		h = xxMergeRound(h, v3)
+		h = xxMergeRound(h, v4)
+	} else {
+		h = seed + xxPrime5
+	}
+	h += uint64(n)
+	for ; len(b) >= 8; b = b[8:] {
+		h ^= xxRound(0, binary.LittleEndian.Uint64(b))
+		h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	}
+	if len(b) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(b)) * xxPrime1
+		h = bits.RotateLeft64(h, 23)*xxPrime2 + xxPrime3
+		b = b[4:]
+	}
+	for _, c := range b {
+		h ^= uint64(c) * xxPrime5
+		h = bits.RotateLeft64(h, 11) * xxPrime1
+	}
+	return xxAvalanche(h)
+}
+
+// xxHash64Uint64 is XXHash64 of the 8 little endian bytes of x, seed 0.
+func xxHash64Uint64(x uint64) uint64 {
+	h := xxPrime5 + 8
+	h ^= xxRound(0, x)
+	h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	return xxAvalanche(h)
+}
+
+func xxRound(acc, input uint64) uint64 {
+	acc += input * xxPrime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxPrime1
+}
+
+func xxMergeRound(acc, val uint64) uint64 {
+	acc ^= xxRound(0, val)
+	return acc*xxPrime1 + xxPrime4
+}
+
+func xxAvalanche(h uint64) uint64 {
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+	return h
+}
+
+// Murmur3 returns the 128-bit MurmurHash3 (x64 variant) of b as two 64-bit halves.
+// Use the first one as a hash for an HLL.
+// The seed is a uint64 (it initializes both 64-bit halves): seeds below 2^32 give the same results as the reference
+// implementation, which takes a uint32.
+func Murmur3(b []byte, seed uint64) (uint64, uint64) {
+	const (
+		c1 = 0x87c37b91114253d5
+		c2 = 0x4cf5ad432745937f
+	)
+	n := len(b)
+	h1, h2 := seed, seed
+	for ; len(b) >= 16; b = b[16:] {
+		k1 := binary.LittleEndian.Uint64(b)
+		k2 := binary.LittleEndian.Uint64(b[8:])
+		k1 *= c1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= c2
+		h1 ^= k1
+		h1 = bits.RotateLeft64(h1, 27)
+		h1 += h2
+		h1 = h1*5 + 0x52dce729
+		k2 *= c2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= c1
+		h2 ^= k2
+		h2 = bits.RotateLeft64(h2, 31)
+		h2 += h1
+		h2 = h2*5 + 0x38495ab5
+	}
+	if len(b) > 8 {
+		var k2 uint64
+		for i := len(b) - 1; i >= 8; i-- {
+			k2 = k2<<8 | uint64(b[i])
+		}
+		k2 *= c2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= c1
+		h2 ^= k2
+	}
+	if len(b) > 0 {
+		if len(b) > 8 {
+			b = b[:8]
+		}
+		var k1 uint64
+		for i := len(b) - 1; i >= 0; i-- {
+			k1 = k1<<8 | uint64(b[i])
+		}
+		k1 *= c1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= c2
+		h1 ^= k1
+	}
+	h1 ^= uint64(n)
+	h2 ^= uint64(n)
+	h1 += h2
+	h2 += h1
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+	h1 += h2
+	h2 += h1
+	return h1, h2
+}
+
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
+
+// AddBytes hashes b with the built-in hash (XXHash64, seed 0) and adds it to the HLL.
+func (h HLL) AddBytes(b []byte) {
+	h.Add(XXHash64(b, 0))
+}
+
+// AddString hashes s with the built-in hash (XXHash64, seed 0) and adds it to the HLL.
+func (h HLL) AddString(s string) {
+	h.Add(XXHash64([]byte(s), 0))
+}
+
+// AddUint64 hashes x (as 8 little endian bytes) with the built-in hash (XXHash64, seed 0) and adds it to the HLL.
+func (h HLL) AddUint64(x uint64) {
+	h.Add(xxHash64Uint64(x))
+}
+
+// AddBytes hashes b with the built-in hash (XXHash64, seed 0) and adds it to the HLL.
+// Returns true if cardinality esimate changed.
+func (h Dense) AddBytes(b []byte) bool {
+	return h.Add(XXHash64(b, 0))
+}
+
+// AddString hashes s with the built-in hash (XXHash64, seed 0) and adds it to the HLL.
+// Returns true if cardinality esimate changed.
+func (h Dense) AddString(s string) bool {
+	return h.Add(XXHash64([]byte(s), 0))
+}
+
+// AddUint64 hashes x (as 8 little endian bytes) with the built-in hash (XXHash64, seed 0) and adds it to the HLL.
+// Returns true if cardinality esimate changed.
+func (h Dense) AddUint64(x uint64) bool {
+	return h.Add(xxHash64Uint64(x))
+}
@@ -0,0 +1,86 @@
+package hll
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestXXHash64(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		seed uint64
+		h    uint64
+	}{
+		{"", 0, 0xef46db3751d8e999},
+		{"a", 0, 0xd24ec4f1a98c6e5b},
+		{"abc", 0, 0x44bc2cf5ad770999},
+		{"Nobody inspects the spammish repetition", 0, 0xfbcea83c8a378bf1},
+	} {
+		if h := XXHash64([]byte(tc.s), tc.seed); h != tc.h {
+			t.Errorf("XXHash64(%q, %d) = %#x, expected %#x", tc.s, tc.seed, h, tc.h)
+		}
+	}
+	var b [8]byte
+	for i := 0; i < 1000; i++ {
+		x := xorShift64StarRound(i)
+		binary.LittleEndian.PutUint64(b[:], x)
+		if xxHash64Uint64(x) != XXHash64(b[:], 0) {
+			t.Fatal(x)
+		}
+	}
+}
+
+func TestMurmur3(t *testing.T) {
+	for _, tc := range []struct {
+		s      string
+		h1, h2 uint64
+	}{
+		{"", 0, 0},
+		{"hello", 0xcbd8a7b341bd9b02, 0x5b1e906a48ae1d19},
+		{"The quick brown fox jumps over the lazy dog", 0xe34bbc7bbc071b6c, 0x7a433ca9c49a9347},
+	} {
+		if h1, h2 := Murmur3([]byte(tc.s), 0); h1 != tc.h1 || h2 != tc.h2 {
+			t.Errorf("Murmur3(%q) = %#x %#x, expected %#x %#x", tc.s, h1, h2, tc.h1, tc.h2)
+		}
+	}
+}
+
+func TestAddHelpers(t *testing.T) {
+	s, _ := SizeByP(14)
+	a := make(HLL, s)
+	b := make(HLL, s)
+	c := make(HLL, s)
+	var buf [8]byte
+	for i := 0; i < 10000; i++ {
+		binary.LittleEndian.PutUint64(buf[:], uint64(i))
+		a.AddBytes(buf[:])
+		b.AddString(string(buf[:]))
+		c.AddUint64(uint64(i))
+	}
+	if a.EstimateCardinality() != b.EstimateCardinality() || a.EstimateCardinality() != c.EstimateCardinality() {
+		t.Fatal(a.EstimateCardinality(), b.EstimateCardinality(), c.EstimateCardinality())
+	}
+	d := make(Dense, s-8)
+	d.AddString("alpha")
+	if !d.AddBytes([]byte("beta")) {
+		t.Fatal("expected a change")
+	}
+	d.AddUint64(7)
+	if d.AddUint64(7) {
+		t.Fatal("expected no change")
+	}
+	if d.EstimateCardinality() != 3 {
+		t.Fatal(d.EstimateCardinality())
+	}
+}
+
+func BenchmarkAddString(b *testing.B) {
+	s, _ := SizeByP(14)
+	h := make(HLL, s)
+	h[0] |= 64 // Dense.
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.AddString("The quick brown fox jumps over the lazy dog")
+	}
+}
@@ -0,0 +1,26 @@
+//go:build go1.23
+
+package hll
+
+import "iter"
+
+// Hashes returns an iterator over the hashes of an exact HLL (see IsExact), each once, in no particular order.
+// Yields nothing if the HLL is not exact. Never modifies the HLL:
+// a dirty one is deduplicated in a temporary buffer first (EstimateCardinality cleans it in place).
+func (h HLL) Hashes() iter.Seq[uint64] {
+	return func(yield func(uint64) bool) {
+		if !h.IsExact() {
+			return
+		}
+		s := sparse(h)
+		if s.dirty() {
+			for _, x := range s.uniqueHashes() {
+				if !yield(x) {
+					return
+				}
+			}
+			return
+		}
+		s.forEachHash(yield)
+	}
+}
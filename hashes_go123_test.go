@@ -0,0 +1,51 @@
+//go:build go1.23
+
+package hll
+
+import (
+	"log"
+	"testing"
+)
+
+func TestHashes(t *testing.T) {
+	s, err := SizeByP(12)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	for i := 0; i < 100; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	for _, clean := range []bool{false, true} {
+		if clean {
+			h.EstimateCardinality()
+		}
+		seen := map[uint64]bool{}
+		for x := range h.Hashes() {
+			if seen[x] {
+				t.Fatal(clean, "duplicate", x)
+			}
+			seen[x] = true
+		}
+		for i := 0; i < 100; i++ {
+			if !seen[xorShift64StarRound(i+1)] {
+				t.Fatal(clean, i)
+			}
+		}
+		if len(seen) != 100 {
+			t.Fatal(clean, len(seen))
+		}
+		n := 0
+		for range h.Hashes() {
+			n++
+			if n == 10 {
+				break
+			}
+		}
+	}
+	h.CompressSparse()
+	for range h.Hashes() {
+		t.Fatal("compressed hll is not exact")
+	}
+}
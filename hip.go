@@ -0,0 +1,114 @@
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// HIPDense is an IncrementalDense that also keeps the Historic Inverse Probability (HIP, martingale) estimate:
+// every time a register changes, the estimate grows by 1/q, q being the probability that a new element changes a register.
+// See "Cardinality estimation: an experimental survey" by Edith Cohen, https://arxiv.org/abs/1306.3284,
+// and "New cardinality estimation algorithms for HyperLogLog sketches" by Otmar Ertl, https://arxiv.org/abs/1702.01284.
+// For a single stream its error is about 0.83/sqrt(m), vs 1.04/sqrt(m) for the classic estimate.
+//
+// HIP depends on the order the registers changed in, so it is only valid for a single stream:
+// after a Merge, HIPEstimate reports false (use EstimateCardinality).
+//
+// Layout:
+// First 8 bytes: the HIP estimate (float64 bits, big endian); NaN once invalid.
+// Followed by an IncrementalDense.
+type HIPDense []byte
+
+const hipHeaderSize = 8
+
+// HIPDenseSizeByP returns a byte size of a HIPDense for a given precision.
+// Precision (p) must be between 4 and 25 (inclusive).
+func HIPDenseSizeByP(p int) (int, error) {
+	s, err := IncrementalDenseSizeByP(p)
+	if err != nil {
+		return 0, err
+	}
+	return s + hipHeaderSize, nil
+}
+
+// HIPDenseSizeByError returns a byte size of a HIPDense for a given errorRate.
+// The error must be between 0.0253% and 26% (inclusive).
+func HIPDenseSizeByError(errorRate float64) (int, error) {
+	s, err := IncrementalDenseSizeByError(errorRate)
+	if err != nil {
+		return 0, err
+	}
+	return s + hipHeaderSize, nil
+}
+
+// IsValid checks whether HLL size makes sense.
+func (h HIPDense) IsValid() error {
+	if len(h) < hipHeaderSize {
+		return errors.New("size too small")
+	}
+	return h.incremental().IsValid()
+}
+
+func (h HIPDense) incremental() IncrementalDense {
+	return IncrementalDense(h[hipHeaderSize:])
+}
+
+// Dense returns the registers. Modifying them directly makes the headers stale.
+func (h HIPDense) Dense() Dense {
+	return h.incremental().Dense()
+}
+
+// Clear resets the HLL (the HIP estimate is valid again).
+func (h HIPDense) Clear() {
+	for i := range h {
+		h[i] = 0
+	}
+}
+
+// Add a hash to an HLL.
+// Returns true if cardinality esimate changed.
+func (h HIPDense) Add(hash uint64) bool {
+	inc := h.incremental()
+	d := inc.Dense()
+	idx, r := d.register(hash)
+	v := d.get(idx)
+	if v >= r {
+		return false
+	}
+	// The probability a new element changes a register, before this one did.
+	q := inc.invSum() / float64(d.m())
+	if hip := h.hip(); !math.IsNaN(hip) {
+		binary.BigEndian.PutUint64(h, math.Float64bits(hip+1/q))
+	}
+	d.set(idx, r)
+	inc.update(v, r)
+	return true
+}
+
+// Merge a Dense (of the same precision) into this. The HIP estimate is invalid afterwards (see HIPEstimate).
+func (h HIPDense) Merge(g Dense) error {
+	if err := h.incremental().Merge(g); err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint64(h, math.Float64bits(math.NaN()))
+	return nil
+}
+
+// HIPEstimate returns the HIP estimate, false if it is invalid (after a Merge).
+func (h HIPDense) HIPEstimate() (uint64, bool) {
+	hip := h.hip()
+	if math.IsNaN(hip) {
+		return 0, false
+	}
+	return round(hip), true
+}
+
+// EstimateCardinality returns the classic estimate (as Dense.EstimateCardinality does), valid after a Merge too.
+func (h HIPDense) EstimateCardinality() uint64 {
+	return h.incremental().EstimateCardinality()
+}
+
+func (h HIPDense) hip() float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(h))
+}
@@ -0,0 +1,87 @@
+package hll
+
+import (
+	"bytes"
+	"log"
+	"math"
+	"testing"
+)
+
+func TestHIPDense(t *testing.T) {
+	s, err := HIPDenseSizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HIPDense, s)
+	if err := h.IsValid(); err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := h.HIPEstimate(); !ok || c != 0 {
+		t.Fatal(c, ok)
+	}
+	// Every element changes a register at first: q is 1 while all of them are zero.
+	h.Add(1 << 63)
+	if c, ok := h.HIPEstimate(); !ok || c != 1 {
+		t.Fatal(c, ok)
+	}
+	h.Clear()
+
+	const trials = 100
+	for _, n := range []int{100, 3000, 100000} {
+		var hipErr, classicErr float64
+		for k := 0; k < trials; k++ {
+			h.Clear()
+			for i := 0; i < n; i++ {
+				h.Add(xxHash64Uint64(uint64(k*n + i)))
+			}
+			hip, ok := h.HIPEstimate()
+			if !ok {
+				t.Fatal("hip is invalid")
+			}
+			hipErr += math.Pow(float64(hip)/float64(n)-1, 2)
+			classicErr += math.Pow(float64(h.EstimateCardinality())/float64(n)-1, 2)
+		}
+		hipErr, classicErr = math.Sqrt(hipErr/trials), math.Sqrt(classicErr/trials)
+		if hipErr > classicErr || hipErr > 1.5*0.83/32 {
+			t.Fatal(n, hipErr, classicErr)
+		}
+	}
+}
+
+func TestHIPDenseMerge(t *testing.T) {
+	s, err := HIPDenseSizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h, g := make(HIPDense, s), make(HIPDense, s)
+	want := make(Dense, len(h.Dense()))
+	for i := 0; i < 5000; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+		g.Add(xorShift64StarRound(i + 2001))
+		want.Add(xorShift64StarRound(i + 1))
+		want.Add(xorShift64StarRound(i + 2001))
+	}
+	if err := h.Merge(g.Dense()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := h.HIPEstimate(); ok {
+		t.Fatal("hip should be invalid after a merge")
+	}
+	if !bytes.Equal(h.Dense(), want) {
+		t.Fatal("registers differ")
+	}
+	if a, b := h.EstimateCardinality(), want.EstimateCardinality(); a != b && a != b+1 && a+1 != b {
+		t.Fatal(a, b)
+	}
+	h.Add(0)
+	if _, ok := h.HIPEstimate(); ok {
+		t.Fatal("hip should stay invalid")
+	}
+	if err := h.Merge(make(Dense, 3)); err != ErrSizeMismatch {
+		t.Fatal(err)
+	}
+	h.Clear()
+	if _, ok := h.HIPEstimate(); !ok {
+		t.Fatal("hip should be valid after Clear")
+	}
+}
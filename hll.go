@@ -16,13 +16,15 @@ import (
 // First 8 bit header.
 // Leftmost bit: dirty.
 // Second left most: mode (1: dense, 0: sparse).
+// Third: layout (1: standard, see SetStandardLayout).
 //
 // sparse:
-//   Next 30 bits: number of elements (big endian), 32 bits unused, followed by elements, 8 bytes each (uint64 little endian).
+//   Next 29 bits: number of elements (big endian), 32 bits unused, followed by elements, 8 bytes each (uint64 little endian).
 //   Note, the header is a part of sparse HLL.
+//   Compressed sparse (see CompressSparse) sets the leftmost of the 32 unused bits.
 //
 // dense:
-//   Next 62 bits: previous cardinality esimate (big endian). Valid if !dirty. Followed by dense HLL.
+//   Next 61 bits: previous cardinality esimate (big endian). Valid if !dirty. Followed by dense HLL.
 // full: 8 byte header, hll[0]&(1<<6) != 0, followed by dense HLL.
 //
 // All operations are in place. Add/Merge might allocate a temporary buffer when switching from sparse to dense representation.
@@ -39,6 +41,9 @@ import (
 // h is empty and sparse at this point.
 type HLL []byte
 
+// ErrSizeMismatch is returned when HLLs of different precisions are combined.
+var ErrSizeMismatch = errors.New("size mismatch")
+
 // SizeByError returns a byte size of an HLL for a given errorRate.
 // The error must be between 0.0253% and 26% (inclusive).
 func SizeByError(errorRate float64) (int, error) {
@@ -64,7 +69,7 @@ func SizeByP(p int) (int, error) {
 // Make sure to use a good hash function.
 func (h HLL) Add(hash uint64) {
 	if h[0]&(1<<6) != 0 {
-		if Dense(h[8:]).Add(hash) {
+		if Dense(h[8:]).add(hash, h.IsStandardLayout()) {
 			h[0] |= 1 << 7 // Mark as dirty.
 		}
 		return
@@ -74,14 +79,20 @@ func (h HLL) Add(hash uint64) {
 		return
 	}
 	toDense(s)
-	Dense(h[8:]).Add(hash)
+	Dense(h[8:]).add(hash, h.IsStandardLayout())
 }
 
-// Merge another HLL (of the same precision) into this.
+// Merge another HLL (of the same precision and layout) into this.
 // Might allocate a block (with Alloc) if HLL is sparse and it gets full.
 func (h HLL) Merge(g HLL) error {
 	if len(h) != len(g) {
-		return errors.New("size mismatch")
+		return ErrSizeMismatch
+	}
+	if (h[0]^g[0])&standardFlag != 0 {
+		return ErrLayoutMismatch
+	}
+	if &h[0] == &g[0] {
+		return nil // Merging into itself is a no-op (and Merge can not work in place over its own input).
 	}
 	hDense := h[0]&(1<<6) != 0
 	gDense := g[0]&(1<<6) != 0
@@ -96,7 +107,6 @@ func (h HLL) Merge(g HLL) error {
 		}
 		toDense(sparse(h))
 		mergeIntoDense(Dense(h[8:]), sparse(g))
-		h[0] = 128 + 64
 		return nil
 	}
 	if hDense { // !g.Dense
@@ -132,7 +142,15 @@ func (h HLL) IsValid() error {
 		return errors.New("p must be between 4 and 25, inclusive")
 	}
 	if h[0]&(1<<6) == 0 {
-		sz := sparse(h).size()
+		s := sparse(h)
+		if s.compressed() {
+			c := compressed(s)
+			if len(h) < 8+c.listSize()+4*c.tailSize() {
+				return errors.New("sparse HLL is corrupted")
+			}
+			return nil
+		}
+		sz := s.size()
 		if len(h) < 8+8*int(sz) {
 			return errors.New("sparse HLL is corrupted")
 		}
@@ -140,7 +158,7 @@ func (h HLL) IsValid() error {
 	return nil
 }
 
-// IsSparse returns true iff the underlying HLL is sparse (and thus the cardinality estimate is exact).
+// IsSparse returns true iff the underlying HLL is sparse (and thus the cardinality estimate is exact, unless it is compressed).
 func (h HLL) IsSparse() bool {
 	return h[0]&64 == 0
 }
@@ -149,27 +167,66 @@ func (h HLL) IsSparse() bool {
 // Note, EstimateCardinality might (will) modify the HLL iff HLL is dirty.
 func (h HLL) EstimateCardinality() uint64 {
 	if h[0]&(1<<6) != 0 {
-		const mask = uint64(1<<63 + 1<<62)
+		const mask = uint64(1<<63 + 1<<62 + 1<<61)
 		if h[0]&(1<<7) == 0 { // Not dirty.
 			return binary.BigEndian.Uint64(h) & (^mask)
 		}
 		card := Dense(h[8:]).EstimateCardinality()
 		if card&mask != 0 {
-			// Wow. carinality is 2^62+. Keep it marked as dirty, so we keep recomputing this absurd cardinality.
+			// Wow. carinality is 2^61+. Keep it marked as dirty, so we keep recomputing this absurd cardinality.
 			return card
 		}
-		binary.BigEndian.PutUint64(h, card|1<<62) // Clear the dirty bit.
+		binary.BigEndian.PutUint64(h, card|1<<62|uint64(h[0]&standardFlag)<<56) // Clear the dirty bit, keep the layout.
 		return card
 	}
+	if s := sparse(h); s.compressed() {
+		if compressed(s).flush() == full {
+			toDense(s)
+			return h.EstimateCardinality()
+		}
+		return compressed(s).EstimateCardinality()
+	}
 	return uint64(sparse(h).EstimateCardinality())
 }
 
-// Reset the HLL.
+// PeekCardinality returns the same estimate as EstimateCardinality, but never modifies the HLL.
+// Safe to use on read-only memory (say, a read-only SketchFile) and from concurrent readers.
+// It is slower for dirty HLLs: dense one is re-estimated on every call,
+// sparse one is deduplicated in a temporary buffer (a compressed one is estimated from a copy,
+// as it might turn dense on EstimateCardinality).
+func (h HLL) PeekCardinality() uint64 {
+	if h[0]&(1<<6) != 0 {
+		const mask = uint64(1<<63 + 1<<62 + 1<<61)
+		if h[0]&(1<<7) == 0 { // Not dirty.
+			return binary.BigEndian.Uint64(h) & (^mask)
+		}
+		return Dense(h[8:]).EstimateCardinality()
+	}
+	s := sparse(h)
+	if s.compressed() {
+		if !s.dirty() {
+			return compressed(s).EstimateCardinality()
+		}
+		tmp := HLL(Alloc(len(h)))
+		copy(tmp, h)
+		card := tmp.EstimateCardinality()
+		Free(tmp)
+		return card
+	}
+	if !s.dirty() {
+		return uint64(s.size())
+	}
+	return uint64(len(s.uniqueHashes()))
+}
+
+// Reset the HLL. The layout (see SetStandardLayout) is kept.
 func (h HLL) Reset() {
+	layout := h[0] & standardFlag
 	// Technically it is enough to clear the first 8 bytes. Let's be diligent.
 	for i := range h {
 		h[i] = 0
 	}
+	h[0] = layout
 }
 
 // Alloc allocates the memory blob. It is a variable, so one can change it to use, say, sync.Pool.
@@ -186,26 +243,80 @@ func toDense(s sparse) {
 	mergeIntoDense(tmp, s)
 	copy(s[8:], tmp)
 	Free(tmp)
-	s[0] = 128 + 64 // dirty + dense
+	s[0] = 128 + 64 | s[0]&standardFlag // dirty + dense, same layout
 }
 
+// mergeIntoDense adds the hashes of s to h, in the layout of s.
 func mergeIntoDense(h Dense, s sparse) {
-	sz := int(s.size())
-	for i := 0; i < sz; i++ {
-		s = s[8:]
-		hash := binary.LittleEndian.Uint64(s)
-		h.Add(hash)
-	}
+	standard := s.standard()
+	s.forEachHash(func(hash uint64) bool {
+		h.add(hash, standard)
+		return true
+	})
 }
 
+// mergeIntoSparse adds the hashes of s to t. t gets compressed if s is.
+// A clean s is merged in O(n + m), t is sorted first if needed.
 func mergeIntoSparse(t sparse, s sparse) addResult {
-	sz := int(s.size())
-	for i := 0; i < sz; i++ {
-		s = s[8:]
-		hash := binary.LittleEndian.Uint64(s)
-		if t.Add(hash) == full {
-			return full
+	if s.compressed() {
+		compress(t)
+	}
+	if !t.compressed() && !s.dirty() {
+		if t.dirty() {
+			t.sort()
+		}
+		return mergeSorted(t, s)
+	}
+	if s.forEachHash(func(hash uint64) bool {
+		return t.Add(hash) == ok
+	}) {
+		return ok
+	}
+	return full
+}
+
+// mergeSorted merges clean s into clean t, keeping t clean. Returns full (leaving t as is) if the union does not fit.
+func mergeSorted(t sparse, s sparse) addResult {
+	n, m := int(t.size()), int(s.size())
+	x, y := t[8:8+8*n], s[8:8+8*m]
+	// Count the union first, so the merge can go from the back, in place.
+	u := n + m
+	for i, j := 0, 0; i < len(x) && j < len(y); {
+		a, b := binary.BigEndian.Uint64(x[i:]), binary.BigEndian.Uint64(y[j:])
+		switch {
+		case a < b:
+			i += 8
+		case a > b:
+			j += 8
+		default:
+			u--
+			i += 8
+			j += 8
+		}
+	}
+	if 8+8*u > len(t) {
+		return full
+	}
+	// The write position never overtakes the unread hashes of t: the union left is at least as large.
+	w, i, j := 8+8*u, 8+8*n, 8*m
+	for j > 0 {
+		b := binary.BigEndian.Uint64(y[j-8:])
+		if i > 8 {
+			a := binary.BigEndian.Uint64(t[i-8:])
+			if a > b {
+				i -= 8
+				w -= 8
+				binary.BigEndian.PutUint64(t[w:], a)
+				continue
+			}
+			if a == b {
+				i -= 8
+			}
 		}
+		j -= 8
+		w -= 8
+		binary.BigEndian.PutUint64(t[w:], b)
 	}
+	t.setSize(uint32(u))
 	return ok
 }
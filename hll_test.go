@@ -1,10 +1,10 @@
 package hll
 
 import (
+	"bytes"
 	"log"
 	"math"
 	"math/rand"
-	"sort"
 	"testing"
 )
 
@@ -309,22 +309,75 @@ func TestEstimateCardinalityCached(t *testing.T) {
 	}
 }
 
+func TestPeekCardinality(t *testing.T) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	for _, n := range []int{0, 5, 50, 1000, 100000} {
+		for _, sd := range []byte{0, 64} {
+			h.Reset()
+			h[0] = sd
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i % (n/2 + 1))) // With duplicates.
+			}
+			for k := 0; k < 2; k++ { // Dirty, then clean.
+				h0 := append(HLL(nil), h...)
+				c := h.PeekCardinality()
+				if !bytes.Equal(h, h0) {
+					t.Fatal("modified")
+				}
+				if e := h.EstimateCardinality(); c != e {
+					t.Fatal(n, sd, c, e)
+				}
+			}
+		}
+	}
+}
+
+// TestPeekCardinalityCompressed checks dirty compressed HLLs, up to the point where the tail no longer fits
+// and EstimateCardinality turns them dense.
+func TestPeekCardinalityCompressed(t *testing.T) {
+	s, err := SizeByP(8)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	h.CompressSparse()
+	checked := 0
+	for i := 0; h.IsSparse(); i++ {
+		h.Add(xorShift64StarRound(i))
+		if !sparse(h).dirty() {
+			continue
+		}
+		checked++
+		h0 := append(HLL(nil), h...)
+		c := h0.PeekCardinality()
+		if !bytes.Equal(h, h0) {
+			t.Fatal("modified")
+		}
+		if e := h0.EstimateCardinality(); c != e {
+			t.Fatal(i, c, e, h0.IsSparse())
+		}
+	}
+	if checked == 0 {
+		t.Fatal("no dirty compressed HLL checked")
+	}
+}
+
 func TestBiasCorrecton(t *testing.T) {
-	if len(rawEstimateData) != len(biasData) {
+	if len(rawEstimateData) != len(biasData) || len(rawEstimateData) != len(thresholdData) {
 		t.Fatal("bias correction data is off")
 	}
 	for i, r := range rawEstimateData {
 		if len(r) != len(biasData[i]) {
 			t.Fatalf("bias correction data is off for index %d", i)
 		}
-		if !sort.IsSorted(sort.Float64Slice(r)) {
-			for k := 1; k < len(r); k++ {
-				if r[k] <= r[k-1] {
-					t.Logf("raw  estimate data is not monotone for index %d:%d %v", i, k, r[k-1:k+1])
-				}
-			}
-			if i > 2 {
-				t.Fatalf("raw  estimate data is not sorted for index %d\n%v", i, r)
+		// estimateBias interpolates between consecutive raw estimates.
+		for k := 1; k < len(r); k++ {
+			if r[k] <= r[k-1] {
+				t.Fatalf("raw  estimate data is not monotone for index %d:%d %v", i, k, r[k-1:k+1])
 			}
 		}
 	}
@@ -0,0 +1,152 @@
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// IncrementalDense is a Dense HLL that keeps what the estimate needs up to date in Add and Merge,
+// so EstimateCardinality is O(1) rather than a scan of all the registers.
+//
+// Layout:
+// First 16 bytes (big endian): number of non-zero registers (32 bits),
+// followed by m - sum(2^-register), as a 96 bit fixed point number (32 bits integer part, 64 bits fraction).
+// Both are zero for an empty HLL and the sum is exact, so it never drifts.
+// Followed by the registers, same as Dense.
+//
+// Creating an IncrementalDense:
+//
+//	s, err := IncrementalDenseSizeByP(p)
+//	if err != nil {
+//		log.Panicln(err)
+//	}
+//	h := make(IncrementalDense, s)
+type IncrementalDense []byte
+
+const incrementalHeaderSize = 16
+
+// IncrementalDenseSizeByP returns a byte size of an IncrementalDense for a given precision.
+// Precision (p) must be between 4 and 25 (inclusive).
+func IncrementalDenseSizeByP(p int) (int, error) {
+	s, err := DenseSizeByP(p)
+	if err != nil {
+		return 0, err
+	}
+	return s + incrementalHeaderSize, nil
+}
+
+// IncrementalDenseSizeByError returns a byte size of an IncrementalDense for a given errorRate.
+// The error must be between 0.0253% and 26% (inclusive).
+func IncrementalDenseSizeByError(errorRate float64) (int, error) {
+	s, err := DenseSizeByError(errorRate)
+	if err != nil {
+		return 0, err
+	}
+	return s + incrementalHeaderSize, nil
+}
+
+// IsValid checks whether HLL size makes sense.
+func (h IncrementalDense) IsValid() error {
+	if len(h) < incrementalHeaderSize {
+		return errors.New("size too small")
+	}
+	return h.Dense().IsValid()
+}
+
+// Dense returns the registers. Modifying them directly makes the header stale (see Recompute).
+func (h IncrementalDense) Dense() Dense {
+	return Dense(h[incrementalHeaderSize:])
+}
+
+// Clear resets the HLL.
+func (h IncrementalDense) Clear() {
+	for i := range h {
+		h[i] = 0
+	}
+}
+
+// Add a hash to an HLL.
+// Returns true if cardinality esimate changed.
+func (h IncrementalDense) Add(hash uint64) bool {
+	d := h.Dense()
+	idx, r := d.register(hash)
+	v := d.get(idx)
+	if v >= r {
+		return false
+	}
+	d.set(idx, r)
+	h.update(v, r)
+	return true
+}
+
+// Merge a Dense (of the same precision) into this. Use g.Dense() to merge another IncrementalDense.
+func (h IncrementalDense) Merge(g Dense) error {
+	d := h.Dense()
+	if len(d) != len(g) {
+		return ErrSizeMismatch
+	}
+	for i := 0; i < d.m(); i++ {
+		if v, w := d.get(i), g.get(i); w > v {
+			d.set(i, w)
+			h.update(v, w)
+		}
+	}
+	return nil
+}
+
+// EstimateCardinality returns a cardinality estimate, same as Dense.EstimateCardinality. Never modifies the HLL.
+func (h IncrementalDense) EstimateCardinality() uint64 {
+	m := h.Dense().m()
+	nonZero, _, _ := h.header()
+	return round(correctedEstimate(m, h.invSum(), m-nonZero))
+}
+
+// invSum returns sum(2^-register).
+func (h IncrementalDense) invSum() float64 {
+	_, hi, lo := h.header()
+	return float64(uint64(h.Dense().m())-hi) - float64(lo)*0x1p-64
+}
+
+// Recompute rebuilds the header from the registers (after they were modified directly, or for a blob from an untrusted source).
+func (h IncrementalDense) Recompute() {
+	for i := range h[:incrementalHeaderSize] {
+		h[i] = 0
+	}
+	d := h.Dense()
+	for i := 0; i < d.m(); i++ {
+		if v := d.get(i); v != 0 {
+			h.update(0, v)
+		}
+	}
+}
+
+func (h IncrementalDense) header() (nonZero int, hi, lo uint64) {
+	return int(binary.BigEndian.Uint32(h)), uint64(binary.BigEndian.Uint32(h[4:])), binary.BigEndian.Uint64(h[8:])
+}
+
+// update the header for a register going from v to w (w > v): m - sum grows by 2^-v - 2^-w.
+func (h IncrementalDense) update(v, w byte) {
+	nonZero, hi, lo := h.header()
+	if v == 0 {
+		nonZero++
+	}
+	// In units of 2^-64.
+	vHi, vLo := pow2Neg(v)
+	wHi, wLo := pow2Neg(w)
+	dLo, borrow := bits.Sub64(vLo, wLo, 0)
+	dHi, _ := bits.Sub64(vHi, wHi, borrow)
+	lo, carry := bits.Add64(lo, dLo, 0)
+	hi, _ = bits.Add64(hi, dHi, carry)
+	binary.BigEndian.PutUint32(h, uint32(nonZero))
+	binary.BigEndian.PutUint32(h[4:], uint32(hi))
+	binary.BigEndian.PutUint64(h[8:], lo)
+}
+
+// pow2Neg returns 2^-v in units of 2^-64, as a 128 bit number.
+func pow2Neg(v byte) (hi, lo uint64) {
+	if v == 0 {
+		return 1, 0
+	}
+	return 0, 1 << (64 - uint(v))
+}
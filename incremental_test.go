@@ -0,0 +1,100 @@
+package hll
+
+import (
+	"bytes"
+	"log"
+	"testing"
+)
+
+func TestIncrementalDense(t *testing.T) {
+	for _, p := range []int{4, 10, 14} {
+		s, err := IncrementalDenseSizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		h := make(IncrementalDense, s)
+		if err := h.IsValid(); err != nil {
+			t.Fatal(err)
+		}
+		d := make(Dense, s-incrementalHeaderSize)
+		for i := 0; i < 200000; i++ {
+			x := xorShift64StarRound(i + 1)
+			if h.Add(x) != d.Add(x) {
+				t.Fatal(p, i, "Add results differ")
+			}
+			if i%997 == 0 || i < 100 {
+				if a, b := h.EstimateCardinality(), d.EstimateCardinality(); a != b && a != b+1 && a+1 != b {
+					t.Fatal(p, i, a, b)
+				}
+			}
+		}
+		if !bytes.Equal(h.Dense(), d) {
+			t.Fatal(p, "registers differ")
+		}
+		// The header is exact: the same as recomputed from scratch.
+		g := append(IncrementalDense(nil), h...)
+		g.Recompute()
+		if !bytes.Equal(g, h) {
+			t.Fatal(p, "header drifted")
+		}
+		h.Clear()
+		if h.EstimateCardinality() != 0 {
+			t.Fatal(h.EstimateCardinality())
+		}
+	}
+}
+
+func TestIncrementalDenseMerge(t *testing.T) {
+	s, err := IncrementalDenseSizeByP(12)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h, g := make(IncrementalDense, s), make(IncrementalDense, s)
+	want := make(Dense, s-incrementalHeaderSize)
+	for i := 0; i < 10000; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+		g.Add(xorShift64StarRound(i + 5001))
+		want.Add(xorShift64StarRound(i + 1))
+		want.Add(xorShift64StarRound(i + 5001))
+	}
+	if err := h.Merge(g.Dense()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(h.Dense(), want) {
+		t.Fatal("registers differ")
+	}
+	if a, b := h.EstimateCardinality(), want.EstimateCardinality(); a != b && a != b+1 && a+1 != b {
+		t.Fatal(a, b)
+	}
+	x := append(IncrementalDense(nil), h...)
+	x.Recompute()
+	if !bytes.Equal(x, h) {
+		t.Fatal("header drifted")
+	}
+	if err := h.Merge(make(Dense, 3)); err != ErrSizeMismatch {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkEstimateIncremental(b *testing.B) {
+	s, _ := IncrementalDenseSizeByP(14)
+	h := make(IncrementalDense, s)
+	for i := 0; i < 1<<16; i++ {
+		h.Add(randUint64())
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.EstimateCardinality()
+	}
+}
+
+func BenchmarkAddIncremental(b *testing.B) {
+	s, _ := IncrementalDenseSizeByP(14)
+	h := make(IncrementalDense, s)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := uint64(0); i < uint64(b.N); i++ {
+		h.Add(i)
+	}
+}
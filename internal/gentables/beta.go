@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// LogLog-Beta ("LogLog-Beta and More" by Jason Qin et al.) estimates alpha * m * (m - z) / (beta(z) + sum(2^-register)),
+// z being the number of zero registers, beta(z) = b0 * z + b1 * zl + ... + b7 * zl^7, zl = log(z + 1).
+// The paper gives the coefficients for p = 14 only, fitted to simulations.
+//
+// fitBeta computes them for any p without simulation, in the Poisson model (n hashes, m registers, lambda = n/m):
+// the expected number of zero registers is m * exp(-lambda) and P(register <= k) = exp(-lambda * 2^-k).
+// For each n, the target is the beta that makes the estimate n at the expected values (see betaTarget).
+// The coefficients minimize the largest relative error of the estimate, in standard errors (see sigma),
+// over the cardinalities: Lawson's algorithm, that is least squares with the weights of the worst points growing.
+
+const (
+	// betaPoints is the number of cardinalities (log spaced, from 1 to where the expected number of zero registers is 1/2)
+	// fitted for a precision.
+	betaPoints = 2000
+	// betaIterations is the number of least squares fits of Lawson's algorithm.
+	betaIterations = 100
+)
+
+// fitBeta returns the LogLog-Beta coefficients for precision p.
+func fitBeta(p int) [8]float64 {
+	m := math.Ldexp(1, p)
+	rows := make([][8]float64, betaPoints)
+	ys := make([]float64, betaPoints)
+	weights := make([]float64, betaPoints)
+	for i := range rows {
+		lambda := betaLambda(m, i)
+		z, invSum, beta := betaTarget(m, lambda)
+		// The relative error of the estimate is about the error of beta relative to beta + invSum.
+		w := 1 / (beta + invSum) / sigma(lambda)
+		rows[i] = betaRow(z, w)
+		ys[i] = beta * w
+		weights[i] = 1
+	}
+	var x [8]float64
+	a := make([][8]float64, betaPoints)
+	b := make([]float64, betaPoints)
+	for it := 0; it < betaIterations; it++ {
+		for i, r := range rows {
+			s := math.Sqrt(weights[i])
+			for k := range r {
+				a[i][k] = r[k] * s
+			}
+			b[i] = ys[i] * s
+		}
+		x = leastSquares(a, b)
+		var sum float64
+		for i, r := range rows {
+			var e float64
+			for k := range r {
+				e += r[k] * x[k]
+			}
+			weights[i] *= math.Abs(e - ys[i])
+			sum += weights[i]
+		}
+		for i := range weights {
+			weights[i] /= sum
+		}
+	}
+	return x
+}
+
+// betaLambda returns the i-th fitted number of hashes per register.
+func betaLambda(m float64, i int) float64 {
+	lo, hi := math.Log(1/m), math.Log(math.Log(2*m))
+	return math.Exp(lo + (hi-lo)*float64(i)/(betaPoints-1))
+}
+
+// betaTarget returns the expected number of zero registers, the expected sum(2^-register)
+// and the beta that makes the estimate n = lambda * m at those (registers are capped at 63).
+func betaTarget(m, lambda float64) (z, invSum, beta float64) {
+	prev := 0.0 // P(register <= k - 1).
+	for k := 0; k <= 63; k++ {
+		cdf := 1.0
+		if k < 63 {
+			cdf = math.Exp(-lambda * math.Ldexp(1, -k))
+		}
+		invSum += (cdf - prev) * math.Ldexp(1, -k)
+		prev = cdf
+	}
+	z, invSum = m*math.Exp(-lambda), m*invSum
+	return z, invSum, alpha(int(m))*(m-z)/lambda - invSum
+}
+
+// sigma returns the standard error of an estimate (relative, times sqrt(m)) with lambda hashes per register:
+// that of linear counting while it is below the 1.04 of HyperLogLog.
+func sigma(lambda float64) float64 {
+	if lc := math.Sqrt(math.Expm1(lambda)-lambda) / lambda; lc < 1.04 {
+		return lc
+	}
+	return 1.04
+}
+
+// betaRow returns the terms of beta(z), times w.
+func betaRow(z, w float64) (r [8]float64) {
+	zl := math.Log(z + 1)
+	r[0] = z * w
+	x := w
+	for k := 1; k < 8; k++ {
+		x *= zl
+		r[k] = x
+	}
+	return r
+}
+
+// betaEstimate returns the LogLog-Beta estimate with coefficients b, as logLogBetaEstimate in the hll package does.
+func betaEstimate(b [8]float64, m, z, invSum float64) float64 {
+	r := betaRow(z, 1)
+	var beta float64
+	for k := range r {
+		beta += r[k] * b[k]
+	}
+	return alpha(int(m)) * m * (m - z) / (beta + invSum)
+}
+
+// leastSquares returns x minimizing |A x - y| (a holds the rows of A), by QR decomposition (modified Gram-Schmidt)
+// of A with the columns scaled to unit length.
+func leastSquares(a [][8]float64, y []float64) [8]float64 {
+	const n = 8
+	var scale [n]float64
+	for j := 0; j < n; j++ {
+		var s float64
+		for _, r := range a {
+			s += r[j] * r[j]
+		}
+		scale[j] = math.Sqrt(s)
+	}
+	q := make([][n]float64, len(a))
+	for i, r := range a {
+		for j := range r {
+			q[i][j] = r[j] / scale[j]
+		}
+	}
+	var r [n][n]float64
+	for j := 0; j < n; j++ {
+		for k := 0; k < j; k++ {
+			var d float64
+			for i := range q {
+				d += q[i][k] * q[i][j]
+			}
+			r[k][j] = d
+			for i := range q {
+				q[i][j] -= d * q[i][k]
+			}
+		}
+		var s float64
+		for i := range q {
+			s += q[i][j] * q[i][j]
+		}
+		r[j][j] = math.Sqrt(s)
+		for i := range q {
+			q[i][j] /= r[j][j]
+		}
+	}
+	var x [n]float64
+	for j := n - 1; j >= 0; j-- {
+		var s float64
+		for i := range q {
+			s += q[i][j] * y[i]
+		}
+		for k := j + 1; k < n; k++ {
+			s -= r[j][k] * x[k]
+		}
+		x[j] = s / r[j][j]
+	}
+	for j := range x {
+		x[j] /= scale[j]
+	}
+	return x
+}
+
+// generateBeta returns the source of loglog_beta_data.go.
+func generateBeta(coefficients [][8]float64) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/gentables; DO NOT EDIT.\n\npackage hll\n\n")
+	b.WriteString("// logLogBetaData is the LogLog-Beta coefficients (see logLogBetaEstimate), by precision,\n")
+	b.WriteString("// fitted in the Poisson model (see internal/gentables).\n")
+	fmt.Fprintf(&b, "var logLogBetaData = [%d][8]float64{\n", len(coefficients))
+	for i, c := range coefficients {
+		b.WriteString("{")
+		for k, x := range c {
+			if k > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(strconv.FormatFloat(x, 'g', -1, 64))
+		}
+		fmt.Fprintf(&b, "}, // precision %d\n", minP+i)
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
@@ -0,0 +1,296 @@
+// Command gentables generates the bias correction tables and the linear counting thresholds (bias_correction_data.go)
+// from simulated streams, hashed into registers the way Dense.Add does. Run it with go generate in the root directory.
+//
+// For every precision p in 4...25 it adds random hashes to many HLLs and records the raw estimate
+// (alpha * m^2 / sum(2^-register)) at 200 cardinalities up to 5m:
+// the mean is the raw estimate table, the mean minus the cardinality the bias table (see estimateBias).
+// The threshold is the one (linear counting below it, see correctedEstimate) with the least mean squared relative error
+// over those cardinalities.
+//
+// It takes over an hour on a single core, most of it for the largest precisions; the trials run on all cores.
+//
+// It also fits the LogLog-Beta coefficients (loglog_beta_data.go, see fitBeta): that needs no simulation,
+// and takes seconds. Run with -o "" to only do that.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"math/bits"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const (
+	minP = 4
+	maxP = 25
+	// points is the number of cardinalities per precision (fewer for small p: they are integers).
+	points = 200
+	// minTrials, maxTrials bound the number of streams per precision.
+	// The noise of the tables is about 1/sqrt(trials) of the error of an estimate, whatever the precision.
+	minTrials = 500
+	maxTrials = 20000
+)
+
+func main() {
+	out := flag.String("o", "bias_correction_data.go", "output file (none if empty)")
+	betaOut := flag.String("beta", "loglog_beta_data.go", "LogLog-Beta output file (none if empty)")
+	hashes := flag.Float64("hashes", 1<<30, "number of hashes to simulate per precision")
+	flag.Parse()
+
+	if *betaOut != "" {
+		var coefficients [][8]float64
+		for p := minP; p <= maxP; p++ {
+			coefficients = append(coefficients, fitBeta(p))
+		}
+		write(*betaOut, generateBeta(coefficients))
+	}
+	if *out == "" {
+		return
+	}
+	var tables []table
+	for p := minP; p <= maxP; p++ {
+		trials := int(*hashes / (5 * math.Ldexp(1, p)))
+		if trials < minTrials {
+			trials = minTrials
+		}
+		if trials > maxTrials {
+			trials = maxTrials
+		}
+		t := simulate(p, trials)
+		log.Printf("p = %d: %d trials, threshold %g", p, trials, t.threshold)
+		tables = append(tables, t)
+	}
+	write(*out, generate(tables))
+}
+
+// write formats the source and writes it into a file.
+func write(path string, src []byte) {
+	src, err := format.Source(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// table is the data for one precision.
+type table struct {
+	raw, bias []float64
+	threshold float64
+}
+
+// generate returns the source of bias_correction_data.go.
+func generate(tables []table) []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/gentables; DO NOT EDIT.\n\npackage hll\n\n")
+	b.WriteString("// Bias-correction data (see estimateBias) from simulated streams: the mean raw estimate\n")
+	b.WriteString("// and its bias at 200 cardinalities up to 5m, by precision.\n")
+	for _, v := range []struct {
+		name string
+		get  func(t table) []float64
+	}{
+		{"rawEstimateData", func(t table) []float64 { return t.raw }},
+		{"biasData", func(t table) []float64 { return t.bias }},
+	} {
+		fmt.Fprintf(&b, "var %s = [][]float64{\n", v.name)
+		for i, t := range tables {
+			fmt.Fprintf(&b, "// precision %d\n{", minP+i)
+			for k, x := range v.get(t) {
+				if k > 0 {
+					b.WriteString(", ")
+				}
+				b.WriteString(strconv.FormatFloat(x, 'g', 7, 64))
+			}
+			b.WriteString("},\n")
+		}
+		b.WriteString("}\n\n")
+	}
+	b.WriteString("// thresholdData is the largest linear counting estimate to use (see threshold), by precision.\n")
+	fmt.Fprintf(&b, "var thresholdData = [%d]float64{", len(tables))
+	for i, t := range tables {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.FormatFloat(t.threshold, 'g', -1, 64))
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+// cardinalities returns the cardinalities to record for m registers.
+func cardinalities(m int) []int {
+	var ns []int
+	for i := 1; i <= points; i++ {
+		n := int(math.Floor(5*float64(m)*float64(i)/points + 0.5))
+		if n > 0 && (len(ns) == 0 || n > ns[len(ns)-1]) {
+			ns = append(ns, n)
+		}
+	}
+	return ns
+}
+
+// simulate runs the streams for precision p.
+func simulate(p, trials int) table {
+	m := 1 << p
+	ns := cardinalities(m)
+	// The raw estimates and the numbers of zero registers, by trial and cardinality.
+	raw := make([]float64, trials*len(ns))
+	zeros := make([]int, trials*len(ns))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			regs := make([]byte, m)
+			for k := range next {
+				o := k * len(ns)
+				run(p, uint64(p)<<32|uint64(k), regs, ns, raw[o:o+len(ns)], zeros[o:o+len(ns)])
+			}
+		}()
+	}
+	for k := 0; k < trials; k++ {
+		next <- k
+	}
+	close(next)
+	wg.Wait()
+
+	t := table{raw: make([]float64, len(ns)), bias: make([]float64, len(ns))}
+	for i, n := range ns {
+		var sum float64
+		for k := 0; k < trials; k++ {
+			sum += raw[k*len(ns)+i]
+		}
+		t.raw[i] = sum / float64(trials)
+		t.bias[i] = t.raw[i] - float64(n)
+	}
+	t.threshold = bestThreshold(m, ns, raw, zeros, &t)
+	return t
+}
+
+// run adds random hashes to the registers (cleared first), recording the raw estimate
+// and the number of zero registers at cardinalities ns.
+func run(p int, seed uint64, regs []byte, ns []int, raw []float64, zeros []int) {
+	for i := range regs {
+		regs[i] = 0
+	}
+	m := len(regs)
+	// hist counts the registers by value.
+	var hist [64]int
+	hist[0] = m
+	n := 0
+	for i, c := range ns {
+		for ; n < c; n++ {
+			idx, rho := register(splitMix64(&seed), p)
+			if old := regs[idx]; rho > old {
+				hist[old]--
+				hist[rho]++
+				regs[idx] = rho
+			}
+		}
+		var invSum float64
+		for k, c := range hist {
+			invSum += math.Ldexp(float64(c), -k)
+		}
+		raw[i] = alpha(m) * float64(m) * float64(m) / invSum
+		zeros[i] = hist[0]
+	}
+}
+
+// register returns the register index and value for a hash, as Dense.Add does:
+// the index is the low p bits, the value the number of leading zeros of the whole hash + 1, at most 63.
+func register(hash uint64, p int) (int, byte) {
+	rho := bits.LeadingZeros64(hash) + 1
+	if rho > 63 {
+		rho = 63
+	}
+	return int(hash & (1<<uint(p) - 1)), byte(rho)
+}
+
+// splitMix64 returns the next random number of the SplitMix64 sequence.
+func splitMix64(x *uint64) uint64 {
+	*x += 0x9e3779b97f4a7c15
+	z := *x
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return z ^ z>>31
+}
+
+// bestThreshold returns the threshold with the least sum of squared relative errors.
+// The candidates are 0 (no linear counting) and the cardinalities.
+func bestThreshold(m int, ns []int, raw []float64, zeros []int, t *table) float64 {
+	type sample struct {
+		lc float64
+		// d is how much linear counting adds to the squared relative error.
+		d float64
+	}
+	samples := make([]sample, len(raw))
+	var total float64
+	for j := range raw {
+		n := float64(ns[j%len(ns)])
+		e := raw[j]
+		if e < 5*float64(m) {
+			e -= t.estimateBias(e)
+		}
+		lc := math.Inf(1)
+		if zeros[j] != 0 {
+			lc = float64(m) * math.Log(float64(m)/float64(zeros[j]))
+		}
+		errE := (e - n) / n
+		errLC := (lc - n) / n
+		total += errE * errE
+		samples[j] = sample{lc, errLC*errLC - errE*errE}
+	}
+	sort.Slice(samples, func(a, b int) bool { return samples[a].lc < samples[b].lc })
+	best, bestErr := 0.0, total
+	var sum float64
+	j := 0
+	for _, n := range ns {
+		for ; j < len(samples) && samples[j].lc <= float64(n); j++ {
+			sum += samples[j].d
+		}
+		if total+sum < bestErr {
+			best, bestErr = float64(n), total+sum
+		}
+	}
+	return best
+}
+
+// estimateBias interpolates the bias at raw estimate x, as estimateBias in the hll package does.
+func (t *table) estimateBias(x float64) float64 {
+	r := t.raw
+	if x < r[0] || x > r[len(r)-1] {
+		return 0
+	}
+	for i := 1; i < len(r); i++ {
+		a, b := r[i-1], r[i]
+		if x > b {
+			continue
+		}
+		return ((x-a)*t.bias[i] + (b-x)*t.bias[i-1]) / (b - a)
+	}
+	return 0
+}
+
+// alpha is the bias correction constant, as in the hll package.
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
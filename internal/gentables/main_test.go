@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"math"
+	"sort"
+	"testing"
+
+	hll "github.com/sasha-s/go-hll"
+)
+
+// TestRegister checks that the simulation puts hashes into the registers as Dense.Add does.
+func TestRegister(t *testing.T) {
+	for _, p := range []int{4, 14} {
+		s, err := hll.DenseSizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		h := make(hll.Dense, s)
+		regs := make([]byte, 1<<uint(p))
+		seed := uint64(p)
+		for i := 0; i < 100000; i++ {
+			hash := splitMix64(&seed) >> uint(i%64)
+			h.Add(hash)
+			if idx, rho := register(hash, p); rho > regs[idx] {
+				regs[idx] = rho
+			}
+		}
+		if !bytes.Equal(h.AppendRegisters(nil), regs) {
+			t.Fatal(p, "registers differ")
+		}
+	}
+}
+
+// TestFitBeta checks the LogLog-Beta fit in the model it is fitted in: the bias is a small fraction of the standard error.
+func TestFitBeta(t *testing.T) {
+	for _, c := range []struct {
+		p   int
+		max float64 // In standard errors.
+	}{{4, 0.01}, {14, 0.01}, {25, 2}} {
+		b := fitBeta(c.p)
+		m := math.Ldexp(1, c.p)
+		for i := 0; i < betaPoints; i++ {
+			lambda := betaLambda(m, i)
+			z, invSum, _ := betaTarget(m, lambda)
+			if d := (betaEstimate(b, m, z, invSum)/(lambda*m) - 1) * math.Sqrt(m) / 1.04; math.Abs(d) > c.max {
+				t.Fatal(c.p, lambda, d)
+			}
+		}
+	}
+	if src := generateBeta([][8]float64{fitBeta(4)}); !bytes.Contains(src, []byte("var logLogBetaData = [1][8]float64{")) {
+		t.Fatal(string(src))
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	tb := simulate(6, 1000)
+	ns := cardinalities(64)
+	if len(tb.raw) != len(ns) || len(tb.bias) != len(ns) || ns[len(ns)-1] != 320 {
+		t.Fatal(len(tb.raw), len(tb.bias), ns)
+	}
+	if !sort.Float64sAreSorted(tb.raw) {
+		t.Fatal("raw estimates are not sorted", tb.raw)
+	}
+	// Small cardinalities are overestimated a lot, large ones hardly at all.
+	if tb.bias[0] < 20 || math.Abs(tb.bias[len(ns)-1]) > 0.05*320 {
+		t.Fatal(tb.bias)
+	}
+	if tb.threshold < 16 || tb.threshold > 320 {
+		t.Fatal(tb.threshold)
+	}
+	if src := generate([]table{tb}); !bytes.Contains(src, []byte("var thresholdData = [1]float64{")) {
+		t.Fatal(string(src))
+	}
+}
@@ -0,0 +1,342 @@
+package hll
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+)
+
+// IntersectionEstimate returns an estimate of the number of elements present in both a and b.
+// a and b must have the same precision and layout; neither is modified.
+//
+// If both are sparse (and not compressed) the answer is exact.
+// Otherwise the joint maximum likelihood method is used (see "New cardinality estimation algorithms
+// for HyperLogLog sketches" by Otmar Ertl, https://arxiv.org/abs/1702.01284), which is much more
+// accurate than inclusion–exclusion when the intersection is small compared to the union.
+func IntersectionEstimate(a, b HLL) (uint64, error) {
+	_, _, both, err := jointEstimate(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return round(both), nil
+}
+
+// JaccardEstimate returns an estimate of |a ∩ b| / |a ∪ b|, 0 if both are empty.
+// See IntersectionEstimate.
+func JaccardEstimate(a, b HLL) (float64, error) {
+	onlyA, onlyB, both, err := jointEstimate(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if both == 0 {
+		return 0, nil
+	}
+	return both / (onlyA + onlyB + both), nil
+}
+
+// ContainmentEstimate returns an estimate of the fraction of elements of a that are also in b: |a ∩ b| / |a|, 0 if a is empty.
+// See IntersectionEstimate.
+func ContainmentEstimate(a, b HLL) (float64, error) {
+	onlyA, _, both, err := jointEstimate(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if both == 0 {
+		return 0, nil
+	}
+	return both / (onlyA + both), nil
+}
+
+// jointEstimate estimates the sizes of a \ b, b \ a and a ∩ b.
+func jointEstimate(a, b HLL) (onlyA, onlyB, both float64, err error) {
+	if len(a) != len(b) {
+		return 0, 0, 0, ErrSizeMismatch
+	}
+	if (a[0]^b[0])&standardFlag != 0 {
+		return 0, 0, 0, ErrLayoutMismatch
+	}
+	if a[0]&(1<<6) == 0 && b[0]&(1<<6) == 0 && (sparse(a).compressed() || sparse(b).compressed()) {
+		// Compare the indexes of the entries (see compressed) rather than the hashes.
+		x, y := sparse(a).uniqueEntries(), sparse(b).uniqueEntries()
+		n := 0
+		for i, j := 0, 0; i < len(x) && j < len(y); {
+			switch {
+			case x[i]>>6 < y[j]>>6:
+				i++
+			case x[i]>>6 > y[j]>>6:
+				j++
+			default:
+				n++
+				i++
+				j++
+			}
+		}
+		return float64(len(x) - n), float64(len(y) - n), float64(n), nil
+	}
+	if a[0]&(1<<6) == 0 && b[0]&(1<<6) == 0 {
+		x, y := sparse(a).uniqueHashes(), sparse(b).uniqueHashes()
+		n := 0
+		for i, j := 0, 0; i < len(x) && j < len(y); {
+			switch {
+			case x[i] < y[j]:
+				i++
+			case x[i] > y[j]:
+				j++
+			default:
+				n++
+				i++
+				j++
+			}
+		}
+		return float64(len(x) - n), float64(len(y) - n), float64(n), nil
+	}
+	da, db := Dense(a[8:]), Dense(b[8:])
+	if a[0]&(1<<6) == 0 {
+		da = Dense(Alloc(len(a) - 8))
+		defer Free(da)
+		mergeIntoDense(da, sparse(a))
+	}
+	if b[0]&(1<<6) == 0 {
+		db = Dense(Alloc(len(b) - 8))
+		defer Free(db)
+		mergeIntoDense(db, sparse(b))
+	}
+	onlyA, onlyB, both = jointMLE(da, db)
+	return onlyA, onlyB, both, nil
+}
+
+// uniqueHashes returns the sorted set of hashes of s without modifying s.
+// For a compressed s, these are the hashes of the entries (see sparse.entryHash).
+func (s sparse) uniqueHashes() []uint64 {
+	if s.compressed() {
+		es := s.uniqueEntries()
+		hs := make([]uint64, len(es))
+		for i, e := range es {
+			hs[i] = s.entryHash(e)
+		}
+		sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+		return hs
+	}
+	sz := int(s.size())
+	hs := make([]uint64, sz)
+	for i := range hs {
+		hs[i] = binary.LittleEndian.Uint64(s[8+8*i:])
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	n := 0
+	for i, h := range hs {
+		if i == 0 || h != hs[n-1] {
+			hs[n] = h
+			n++
+		}
+	}
+	return hs[:n]
+}
+
+// maxRho is the largest register value. Registers are capped at it.
+const maxRho = 63
+
+// jointStats holds the sufficient statistics of a pair of register arrays (see Ertl, section 5).
+// Index is the register value.
+type jointStats struct {
+	aLess, aGreater [maxRho + 1]float64 // a == k && a < b, a == k && a > b.
+	bLess, bGreater [maxRho + 1]float64 // b == k && b < a, b == k && b > a.
+	equal           [maxRho + 1]float64 // a == b == k.
+	m               float64
+}
+
+func jointMLE(a, b Dense) (onlyA, onlyB, both float64) {
+	var st jointStats
+	m := a.m()
+	st.m = float64(m)
+	var invSumA, invSumB, invSumU float64
+	var zerosA, zerosB, zerosU int
+	for i := 0; i < m; i++ {
+		x, y := a.get(i), b.get(i)
+		switch {
+		case x < y:
+			st.aLess[x]++
+			st.bGreater[y]++
+		case x > y:
+			st.aGreater[x]++
+			st.bLess[y]++
+		default:
+			st.equal[x]++
+		}
+		u := x
+		if y > u {
+			u = y
+		}
+		invSumA += lookup[x]
+		invSumB += lookup[y]
+		invSumU += lookup[u]
+		if x == 0 {
+			zerosA++
+		}
+		if y == 0 {
+			zerosB++
+		}
+		if u == 0 {
+			zerosU++
+		}
+	}
+	na := correctedEstimate(m, invSumA, zerosA)
+	nb := correctedEstimate(m, invSumB, zerosB)
+	nu := correctedEstimate(m, invSumU, zerosU)
+	if nu == 0 {
+		return 0, 0, 0
+	}
+
+	// Start from inclusion–exclusion and maximize the log likelihood over log(λ).
+	x := na + nb - nu
+	floor := nu * 1e-3
+	if x < floor {
+		x = floor
+	}
+	la, lb := na-x, nb-x
+	if la < floor {
+		la = floor
+	}
+	if lb < floor {
+		lb = floor
+	}
+	theta := nelderMead(func(t [3]float64) float64 {
+		return -st.logLikelihood(math.Exp(t[0]), math.Exp(t[1]), math.Exp(t[2]))
+	}, [3]float64{math.Log(la), math.Log(lb), math.Log(x)})
+	return math.Exp(theta[0]), math.Exp(theta[1]), math.Exp(theta[2])
+}
+
+// logLikelihood of the statistics given the Poisson rates of elements only in a, only in b and in both.
+// Register values are 0...maxRho, P(register <= k) = exp(-λ/m * 2^-k) for k < maxRho.
+func (st *jointStats) logLikelihood(la, lb, lx float64) float64 {
+	const q = maxRho - 1
+	// logF is log P(register <= k).
+	logF := func(l float64, k int) float64 {
+		if k > q {
+			return 0
+		}
+		return -l / st.m * math.Ldexp(1, -k)
+	}
+	// logOneMinusR is log(1 - P(register <= k-1) / P(register <= k)).
+	logOneMinusR := func(l float64, k int) float64 {
+		if k == 0 {
+			return 0
+		}
+		if k > q {
+			k = q
+		}
+		return math.Log(-math.Expm1(-l / st.m * math.Ldexp(1, -k)))
+	}
+	oneMinusR := func(l float64, k int) float64 {
+		if k == 0 {
+			return 1
+		}
+		if k > q {
+			k = q
+		}
+		return -math.Expm1(-l / st.m * math.Ldexp(1, -k))
+	}
+	var ll float64
+	for k := 0; k <= maxRho; k++ {
+		if c := st.aLess[k]; c != 0 {
+			ll += c * (logF(la+lx, k) + logOneMinusR(la+lx, k))
+		}
+		if c := st.aGreater[k]; c != 0 {
+			ll += c * (logF(la, k) + logOneMinusR(la, k))
+		}
+		if c := st.bLess[k]; c != 0 {
+			ll += c * (logF(lb+lx, k) + logOneMinusR(lb+lx, k))
+		}
+		if c := st.bGreater[k]; c != 0 {
+			ll += c * (logF(lb, k) + logOneMinusR(lb, k))
+		}
+		if c := st.equal[k]; c != 0 {
+			// P(a == b == k) = Fa Fb Fx (1 - Rx (Ra + Rb - Ra Rb)), where R is P(<= k-1) / P(<= k).
+			// With U = 1 - R this is Fa Fb Fx (Ux + (1 - Ux) Ua Ub).
+			ua, ub, ux := oneMinusR(la, k), oneMinusR(lb, k), oneMinusR(lx, k)
+			ll += c * (logF(la, k) + logF(lb, k) + logF(lx, k) + math.Log(ux+(1-ux)*ua*ub))
+		}
+	}
+	return ll
+}
+
+// nelderMead minimizes f starting from x.
+func nelderMead(f func([3]float64) float64, x [3]float64) [3]float64 {
+	const n = 3
+	var simplex [n + 1][n]float64
+	var values [n + 1]float64
+	for i := range simplex {
+		simplex[i] = x
+		if i > 0 {
+			simplex[i][i-1] += 0.5
+		}
+		values[i] = f(simplex[i])
+	}
+	for iter := 0; iter < 2000; iter++ {
+		// Order: best first.
+		for i := 1; i <= n; i++ {
+			for j := i; j > 0 && values[j] < values[j-1]; j-- {
+				values[j], values[j-1] = values[j-1], values[j]
+				simplex[j], simplex[j-1] = simplex[j-1], simplex[j]
+			}
+		}
+		if math.Abs(values[n]-values[0]) <= 1e-12*math.Abs(values[0])+1e-12 {
+			var size float64
+			for i := 0; i < n; i++ {
+				size = math.Max(size, math.Abs(simplex[n][i]-simplex[0][i]))
+			}
+			if size < 1e-6 {
+				break
+			}
+		}
+		var centroid [n]float64
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				centroid[j] += simplex[i][j] / n
+			}
+		}
+		point := func(t float64) [n]float64 {
+			var p [n]float64
+			for j := range p {
+				p[j] = centroid[j] + t*(simplex[n][j]-centroid[j])
+			}
+			return p
+		}
+		r := point(-1)
+		fr := f(r)
+		switch {
+		case fr < values[0]:
+			e := point(-2)
+			if fe := f(e); fe < fr {
+				simplex[n], values[n] = e, fe
+			} else {
+				simplex[n], values[n] = r, fr
+			}
+		case fr < values[n-1]:
+			simplex[n], values[n] = r, fr
+		default:
+			c := point(0.5)
+			if fr < values[n] {
+				c = point(-0.5)
+			}
+			if fc := f(c); fc < math.Min(fr, values[n]) {
+				simplex[n], values[n] = c, fc
+				continue
+			}
+			// Shrink towards the best point.
+			for i := 1; i <= n; i++ {
+				for j := 0; j < n; j++ {
+					simplex[i][j] = simplex[0][j] + 0.5*(simplex[i][j]-simplex[0][j])
+				}
+				values[i] = f(simplex[i])
+			}
+		}
+	}
+	best := 0
+	for i := range values {
+		if values[i] < values[best] {
+			best = i
+		}
+	}
+	return simplex[best]
+}
@@ -0,0 +1,158 @@
+package hll
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestIntersectionSparse(t *testing.T) {
+	s, _ := SizeByP(14)
+	a := make(HLL, s)
+	b := make(HLL, s)
+	for i := 0; i < 300; i++ {
+		a.AddUint64(uint64(i))
+		a.AddUint64(uint64(i)) // Dirty, with duplicates.
+	}
+	for i := 200; i < 400; i++ {
+		b.AddUint64(uint64(i))
+	}
+	b.EstimateCardinality() // Sorted.
+	a0, b0 := append(HLL(nil), a...), append(HLL(nil), b...)
+	n, err := IntersectionEstimate(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 100 {
+		t.Fatal(n)
+	}
+	if j, _ := JaccardEstimate(a, b); j != 0.25 {
+		t.Fatal(j)
+	}
+	if c, _ := ContainmentEstimate(a, b); c != 1.0/3 {
+		t.Fatal(c)
+	}
+	if c, _ := ContainmentEstimate(b, a); c != 0.5 {
+		t.Fatal(c)
+	}
+	if !a.IsSparse() || !b.IsSparse() {
+		t.Fatal("expected sparse")
+	}
+	if !bytes.Equal(a, a0) || !bytes.Equal(b, b0) {
+		t.Fatal("input modified")
+	}
+	empty := make(HLL, s)
+	if j, _ := JaccardEstimate(empty, empty); j != 0 {
+		t.Fatal(j)
+	}
+	s, _ = SizeByP(12)
+	if _, err := IntersectionEstimate(a, make(HLL, s)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestIntersectionDense(t *testing.T) {
+	s, _ := SizeByP(12)
+	for _, tc := range []struct {
+		onlyA, onlyB, both int
+	}{
+		{100000, 100000, 100000},
+		{200000, 50000, 20000},
+		{1000, 1000, 50000},
+		{1000, 0, 200},
+		{0, 0, 100000},
+		{100000, 100000, 0},
+	} {
+		a := make(HLL, s)
+		b := make(HLL, s)
+		for _, h := range []HLL{a, b} {
+			h[0] = 64 // Dense.
+		}
+		k := 0
+		for i := 0; i < tc.onlyA; i, k = i+1, k+1 {
+			a.AddUint64(uint64(k))
+		}
+		for i := 0; i < tc.onlyB; i, k = i+1, k+1 {
+			b.AddUint64(uint64(k))
+		}
+		for i := 0; i < tc.both; i, k = i+1, k+1 {
+			a.AddUint64(uint64(k))
+			b.AddUint64(uint64(k))
+		}
+		a0, b0 := append(HLL(nil), a...), append(HLL(nil), b...)
+		n, err := IntersectionEstimate(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(a, a0) || !bytes.Equal(b, b0) {
+			t.Fatal("input modified")
+		}
+		union := float64(tc.onlyA + tc.onlyB + tc.both)
+		// The error is relative to the union.
+		if d := math.Abs(float64(n)-float64(tc.both)) / union; d > 10*ErrFromP(12) {
+			t.Errorf("%+v: estimated %d", tc, n)
+		}
+		j, _ := JaccardEstimate(a, b)
+		if d := math.Abs(j - float64(tc.both)/union); d > 10*ErrFromP(12) {
+			t.Errorf("%+v: jaccard %g", tc, j)
+		}
+	}
+}
+
+func TestIntersectionMixed(t *testing.T) {
+	s, _ := SizeByP(14)
+	a := make(HLL, s)
+	b := make(HLL, s)
+	b[0] = 64
+	for i := 0; i < 1000; i++ {
+		a.AddUint64(uint64(i))
+	}
+	for i := 500; i < 100000; i++ {
+		b.AddUint64(uint64(i))
+	}
+	n, err := IntersectionEstimate(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(float64(n)-500) > 100000*ErrFromP(14)*3 {
+		t.Fatal(n)
+	}
+	if !a.IsSparse() {
+		t.Fatal("input modified")
+	}
+}
+
+// The MLE should beat inclusion–exclusion on average.
+func TestIntersectionBetterThanInclusionExclusion(t *testing.T) {
+	s, _ := SizeByP(10)
+	var mleErr, ieErr float64
+	const both = 2000
+	for run := 0; run < 20; run++ {
+		a := make(HLL, s)
+		b := make(HLL, s)
+		u := make(HLL, s)
+		for _, h := range []HLL{a, b, u} {
+			h[0] = 64
+		}
+		for i := 0; i < 50000; i++ {
+			x, y := randUint64(), randUint64()
+			a.Add(x)
+			b.Add(y)
+			u.Add(x)
+			u.Add(y)
+		}
+		for i := 0; i < both; i++ {
+			x := randUint64()
+			a.Add(x)
+			b.Add(x)
+			u.Add(x)
+		}
+		n, _ := IntersectionEstimate(a, b)
+		mleErr += math.Abs(float64(n) - both)
+		ie := float64(a.EstimateCardinality()) + float64(b.EstimateCardinality()) - float64(u.EstimateCardinality())
+		ieErr += math.Abs(ie - both)
+	}
+	if mleErr > ieErr {
+		t.Fatal(mleErr, ieErr)
+	}
+}
@@ -0,0 +1,30 @@
+// Code generated by internal/gentables; DO NOT EDIT.
+
+package hll
+
+// logLogBetaData is the LogLog-Beta coefficients (see logLogBetaEstimate), by precision,
+// fitted in the Poisson model (see internal/gentables).
+var logLogBetaData = [22][8]float64{
+	{151.4840447849948, -152.8902791923788, -72.64708477440408, -30.43463291251918, -1.5775076425716101, -3.8635336797932247, 0.5953727133529816, -0.15113898405186962},                 // precision 4
+	{28.031117437528046, -29.178694987893874, -11.805453940500069, -7.928152681959038, 1.3382119479346208, -1.3868381571378243, 0.25325818622118806, -0.040609061716770214},             // precision 5
+	{6.157672203914794, -7.1247089505412955, -1.4088425622969345, -3.233575691746277, 1.2092852497846667, -0.6325421845903243, 0.1152851106110113, -0.013520085889202983},               // precision 6
+	{1.2904193735319933, -2.1004916942325966, 0.619881951525492, -1.7392926942868554, 0.8324488349020673, -0.32011995092524426, 0.05463853942261107, -0.005041580885267278},             // precision 7
+	{0.1000524310004421, -0.8075364758640895, 0.9649070962168179, -1.137681327142448, 0.5733301887260933, -0.18109175370864752, 0.02831908932159409, -0.0021367581809085454},            // precision 8
+	{-0.23576595736366024, -0.39280092692789764, 0.9495108755311104, -0.8063872774240621, 0.39844326806541047, -0.1076123151991371, 0.015314926890606427, -0.0009540491779455674},       // precision 9
+	{-0.33769017726376105, -0.22995090161929543, 0.8583032782037635, -0.5885077039872333, 0.2776345008821826, -0.06428551487442669, 0.008250090508217497, -0.00040497025697480346},      // precision 10
+	{-0.36926783249910156, -0.151258771970408, 0.7543820657899976, -0.42218813276134254, 0.1861612683436109, -0.034993256347663496, 0.003854033468839857, -0.00010384206034134943},      // precision 11
+	{-0.3783534253473174, -0.10422223922508062, 0.6372762671531191, -0.26198791408737704, 0.09961577984556973, -0.009682383567948663, 0.0003442795019554175, 0.00011238357909385005},    // precision 12
+	{-0.3797632620198023, -0.06410791350156884, 0.46384222554278753, -0.043830676340011004, -0.013926898075763295, 0.020579430689866066, -0.0035235819991531452, 0.0003270476554194017}, // precision 13
+	{-0.37835065405721646, -0.003828117749007503, 0.13378952653324336, 0.34040146712705605, -0.20149293870452395, 0.06616426189552256, -0.00889919032470942, 0.0005963954188752837},     // precision 14
+	{-0.3759695434387682, 0.13718239355091724, -0.5888133078439299, 1.1157640360169496, -0.5525663036266227, 0.14452387716055518, -0.01744992607811692, 0.0009849258532712113},          // precision 15
+	{-0.3732984889970592, 0.5293949912395586, -2.280431355536357, 2.7775125984893974, -1.247390088888931, 0.28761869296173403, -0.03191366411606381, 0.0015837864805999225},             // precision 16
+	{-0.3705612350285906, 1.6314513161410862, -6.28157161964343, 6.375658483644075, -2.6388274946035737, 0.5532872740900554, -0.05684774732631959, 0.0025305486124659856},               // precision 17
+	{-0.367834373251328, 4.6156165677015855, -15.619873182043458, 14.09947482150262, -5.413179912550439, 1.046758613642781, -0.10000044188873902, 0.004043040788886514},                 // precision 18
+	{-0.3651464219179253, 12.356480784680551, -36.985617754719215, 30.45512061365802, -10.896945915577287, 1.9595048571681006, -0.1746465031204275, 0.006472555527802725},               // precision 19
+	{-0.3625096848082294, 31.673339726535648, -84.9003649153381, 64.61560277934446, -21.638044938155236, 3.639221763127729, -0.3035855689218369, 0.010390054795683761},                  // precision 20
+	{-0.35993041272101056, 78.32332239060118, -190.41272305808425, 135.07276596783979, -42.501325572401136, 6.715601741268326, -0.5260018135490679, 0.016727387454164137},               // precision 21
+	{-0.3574136662281312, 187.97800985705962, -419.17855757432926, 278.85748313564756, -82.74301067339343, 12.328222263796668, -0.9093770209264654, 0.027012834870838318},               // precision 22
+	{-0.3549615819693339, 440.00602198685993, -908.400887428107, 569.4873388419445, -159.86800643954982, 22.53180067228174, -1.5697683015470112, 0.04375511573450371},                   // precision 23
+	{-0.3525788628341716, 1008.9097075739131, -1943.5051061502286, 1152.7270852824886, -307.0134574857967, 41.042592695148414, -2.7078263579144823, 0.07110334078775406},                // precision 24
+	{-0.3502696685275042, 2275.938589615695, -4114.642884905267, 2316.313956817844, -586.7591784398098, 74.58006403620621, -4.671081934259325, 0.11594568062120879},                     // precision 25
+}
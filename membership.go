@@ -0,0 +1,62 @@
+package hll
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sort"
+)
+
+// IsExact returns true iff the HLL holds the exact set of hashes: it is sparse and not compressed.
+// Then the estimate, Contains, Hashes and AppendSortedHashes are exact.
+func (h HLL) IsExact() bool {
+	return h[0]&(1<<6) == 0 && !sparse(h).compressed()
+}
+
+// Contains checks whether hash was added to the HLL. Never modifies the HLL.
+// If the HLL is exact (see IsExact), so is the answer.
+// Otherwise present might be a false positive (another hash set the same register), but absent is always right.
+func (h HLL) Contains(hash uint64) (present bool, exact bool) {
+	if h[0]&(1<<6) != 0 {
+		d := Dense(h[8:])
+		idx, r := d.registerFor(hash, h.IsStandardLayout())
+		return d.get(idx) >= r, false
+	}
+	s := sparse(h)
+	if s.compressed() {
+		e := s.entry(hash)
+		return !compressed(s).forEach(func(x uint32) bool {
+			return x>>6 != e>>6 || x < e // Stop at the same index with a rho at least as large.
+		}), false
+	}
+	sz := int(s.size())
+	if !s.dirty() {
+		// Sorted by bytes: as big endian keys.
+		key := bits.ReverseBytes64(hash)
+		i := sort.Search(sz, func(i int) bool { return binary.BigEndian.Uint64(s[8+8*i:]) >= key })
+		return i < sz && binary.BigEndian.Uint64(s[8+8*i:]) == key, true
+	}
+	return !s.forEachHash(func(x uint64) bool { return x != hash }), true
+}
+
+// AppendSortedHashes appends the hashes of an exact HLL (see IsExact) to dst, sorted and without duplicates.
+// Returns dst unchanged and false if the HLL is not exact. Never modifies the HLL.
+func (h HLL) AppendSortedHashes(dst []uint64) ([]uint64, bool) {
+	if !h.IsExact() {
+		return dst, false
+	}
+	n := len(dst)
+	sparse(h).forEachHash(func(x uint64) bool {
+		dst = append(dst, x)
+		return true
+	})
+	hs := dst[n:]
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	k := 0
+	for i, x := range hs {
+		if i == 0 || x != hs[k-1] {
+			hs[k] = x
+			k++
+		}
+	}
+	return dst[:n+k], true
+}
@@ -0,0 +1,90 @@
+package hll
+
+import (
+	"log"
+	"sort"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	s, err := SizeByP(12)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	check := func(n int, exact bool) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			if present, e := h.Contains(xorShift64StarRound(i + 1)); !present || e != exact {
+				t.Fatal(n, i, present, e)
+			}
+		}
+		falsePositives := 0
+		for i := n; i < n+1000; i++ {
+			present, e := h.Contains(xorShift64StarRound(i + 1))
+			if e != exact {
+				t.Fatal(n, i, e)
+			}
+			if present {
+				falsePositives++
+			}
+		}
+		if exact && falsePositives != 0 {
+			t.Fatal(n, "false positives in an exact hll")
+		}
+	}
+	for i := 0; i < 50; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	check(50, true) // Dirty.
+	h.EstimateCardinality()
+	check(50, true) // Clean.
+	if present, _ := h.Contains(0); present {
+		t.Fatal("0 was not added")
+	}
+	g := append(HLL(nil), h...)
+	g.CompressSparse()
+	h = g
+	check(50, false)
+	for i := 50; i < 5000; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	if h.IsSparse() {
+		t.Fatal("should be dense")
+	}
+	check(5000, false)
+}
+
+func TestAppendSortedHashes(t *testing.T) {
+	s, err := SizeByP(12)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	var want []uint64
+	for i := 0; i < 100; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+		h.Add(xorShift64StarRound(i + 1))
+		want = append(want, xorShift64StarRound(i+1))
+	}
+	sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+	for _, clean := range []bool{false, true} {
+		if clean {
+			h.EstimateCardinality()
+		}
+		got, ok := h.AppendSortedHashes([]uint64{42})
+		if !ok || len(got) != 101 || got[0] != 42 {
+			t.Fatal(clean, ok, len(got))
+		}
+		for i, x := range want {
+			if got[i+1] != x {
+				t.Fatal(clean, i)
+			}
+		}
+	}
+	h.CompressSparse()
+	if got, ok := h.AppendSortedHashes(nil); ok || got != nil || h.IsExact() {
+		t.Fatal("compressed hll is not exact")
+	}
+}
@@ -0,0 +1,270 @@
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+// postgresql-hll interoperability (the Aggregate Knowledge storage specification, v1).
+//
+// Layout:
+//
+//	1 byte: version (high nibble, 1) and type (low nibble): 1 EMPTY, 2 EXPLICIT, 3 SPARSE, 4 FULL.
+//	1 byte: regwidth - 1 (high 3 bits) and log2m (low 5 bits).
+//	1 byte: sparse enabled (bit 6) and explicit cutoff (low 6 bits: 0 disabled, 63 auto, c for 2^(c-1)).
+//
+// followed by the data:
+// EXPLICIT: the hashes, 8 bytes each (big endian), sorted as signed integers.
+// SPARSE: the non-zero registers, as log2m bits of index followed by regwidth bits of value, bit-packed (most significant bit first).
+// FULL: all the registers, regwidth bits each, bit-packed.
+//
+// postgresql-hll takes the register index from the low log2m bits of the hash, and the register value
+// from the trailing zeros of the remaining bits.
+// RemapPostgresHash converts a hash, so go-hll puts it into the same register.
+// EXPLICIT maps to a sparse HLL (of remapped hashes), SPARSE and FULL to a dense one.
+
+// PostgresParams are the parameters of a postgresql-hll column: hll(log2m, regwidth, expthresh, sparseon).
+type PostgresParams struct {
+	Log2m     int   // Same as the precision (p), 4 to 25 here.
+	Regwidth  int   // Bits per register, 1 to 8.
+	Expthresh int64 // Explicit (exact) representation threshold: -1 (auto), 0 (disabled) or a power of two.
+	Sparseon  bool  // Whether the SPARSE representation is allowed.
+}
+
+// PostgresParamsByP returns the default postgresql-hll parameters (regwidth 5, expthresh -1, sparseon) for precision p.
+func PostgresParamsByP(p int) PostgresParams {
+	return PostgresParams{Log2m: p, Regwidth: 5, Expthresh: -1, Sparseon: true}
+}
+
+const (
+	postgresVersion  = 1
+	postgresEmpty    = 1
+	postgresExplicit = 2
+	postgresSparse   = 3
+	postgresFull     = 4
+)
+
+// RemapPostgresHash converts a postgresql-hll hash (say, hll_hash_bigint, that is the first half of Murmur3 with seed 0) into
+// a go-hll hash for an HLL with p = log2m, keeping the register index and value.
+// The only exception is a hash with the top 64 - log2m bits zero (postgresql-hll ignores it, go-hll does not).
+func RemapPostgresHash(hash uint64, log2m int) uint64 {
+	mask := uint64(1)<<uint(log2m) - 1
+	return bits.Reverse64(hash>>uint(log2m)) | hash&mask
+}
+
+// unmapPostgresHash is the inverse of RemapPostgresHash.
+func unmapPostgresHash(hash uint64, log2m int) uint64 {
+	mask := uint64(1)<<uint(log2m) - 1
+	return bits.Reverse64(hash&^mask)<<uint(log2m) | hash&mask
+}
+
+// PostgresHash returns the hash of b as hll_hash_bytea (or hll_hash_text) computes it, remapped with RemapPostgresHash.
+// Use hll_hash_bigint's encoding (8 little endian bytes) for integers.
+func PostgresHash(b []byte, log2m int) uint64 {
+	h, _ := Murmur3(b, 0)
+	return RemapPostgresHash(h, log2m)
+}
+
+// DecodePostgres converts a postgresql-hll value into a new HLL, returning the column parameters as well
+// (so the HLL can be written back with EncodePostgres).
+func DecodePostgres(b []byte) (HLL, PostgresParams, error) {
+	var params PostgresParams
+	if len(b) < 3 {
+		return nil, params, errors.New("postgresql hll is too short")
+	}
+	if b[0]>>4 != postgresVersion {
+		return nil, params, errors.New("unsupported postgresql hll version")
+	}
+	if b[2]&(1<<7) != 0 {
+		return nil, params, errors.New("reserved bit of postgresql hll is set")
+	}
+	params.Regwidth = int(b[1]>>5) + 1
+	params.Log2m = int(b[1] & 31)
+	params.Sparseon = b[2]&(1<<6) != 0
+	switch c := b[2] & 63; c {
+	case 0:
+		params.Expthresh = 0
+	case 63:
+		params.Expthresh = -1
+	default:
+		params.Expthresh = 1 << (c - 1)
+	}
+	s, err := SizeByP(params.Log2m)
+	if err != nil {
+		return nil, params, err
+	}
+	h := make(HLL, s)
+	data := b[3:]
+	switch b[0] & 15 {
+	case postgresEmpty:
+		if len(data) != 0 {
+			return nil, params, errors.New("empty postgresql hll has data")
+		}
+	case postgresExplicit:
+		if len(data)%8 != 0 {
+			return nil, params, errors.New("explicit postgresql hll has a partial hash")
+		}
+		for ; len(data) > 0; data = data[8:] {
+			h.Add(RemapPostgresHash(binary.BigEndian.Uint64(data), params.Log2m))
+		}
+	case postgresSparse:
+		d := Dense(h[8:])
+		w := params.Log2m + params.Regwidth
+		for pos := 0; pos+w <= 8*len(data); pos += w {
+			e := getBits(data, pos, w)
+			idx, v := int(e>>uint(params.Regwidth)), byte(e&(1<<uint(params.Regwidth)-1))
+			if v == 0 {
+				continue // Padding.
+			}
+			if v > maxRegister(params.Log2m, idx, false) {
+				return nil, params, errors.New("postgresql hll register is out of range")
+			}
+			if d.get(idx) < v {
+				d.set(idx, v)
+			}
+		}
+		h[0] = 128 + 64 // dirty + dense
+	case postgresFull:
+		d := Dense(h[8:])
+		m := d.m()
+		if len(data) != (m*params.Regwidth+7)/8 {
+			return nil, params, errors.New("full postgresql hll has wrong size")
+		}
+		for i := 0; i < m; i++ {
+			v := byte(getBits(data, i*params.Regwidth, params.Regwidth))
+			if v > maxRegister(params.Log2m, i, false) {
+				return nil, params, errors.New("postgresql hll register is out of range")
+			}
+			d.set(i, v)
+		}
+		h[0] = 128 + 64 // dirty + dense
+	default:
+		return nil, params, errors.New("unknown postgresql hll type")
+	}
+	return h, params, nil
+}
+
+// EncodePostgres converts an HLL into a postgresql-hll value with the given parameters (Log2m must match the precision).
+// The representation is picked the way postgresql-hll does:
+// EXPLICIT while the number of hashes is within Expthresh, SPARSE while it is smaller than FULL (if Sparseon).
+// Register values that do not fit into Regwidth bits are capped.
+func EncodePostgres(h HLL, params PostgresParams) ([]byte, error) {
+	if err := h.IsValid(); err != nil {
+		return nil, err
+	}
+	if h.IsStandardLayout() {
+		return nil, errors.New("standard layout is not supported")
+	}
+	d := Dense(h[8:])
+	if params.Log2m != int(d.p()) {
+		return nil, errors.New("log2m does not match the precision")
+	}
+	if params.Regwidth < 1 || params.Regwidth > 8 {
+		return nil, errors.New("regwidth must be between 1 and 8, inclusive")
+	}
+	var cutoff byte
+	switch e := params.Expthresh; {
+	case e == -1:
+		cutoff = 63
+	case e == 0:
+		cutoff = 0
+	case e > 0 && e&(e-1) == 0 && e <= 1<<61:
+		cutoff = byte(bits.TrailingZeros64(uint64(e))) + 1
+	default:
+		return nil, errors.New("expthresh must be -1, 0 or a power of two")
+	}
+	if params.Sparseon {
+		cutoff |= 1 << 6
+	}
+	b := []byte{postgresVersion << 4, byte(params.Regwidth-1)<<5 | byte(params.Log2m), cutoff}
+
+	m := d.m()
+	fullSize := (m*params.Regwidth + 7) / 8
+	if h[0]&(1<<6) == 0 {
+		hashes := sparse(h).uniqueHashes()
+		if len(hashes) == 0 {
+			b[0] |= postgresEmpty
+			return b, nil
+		}
+		threshold := params.Expthresh
+		if threshold == -1 {
+			threshold = int64(fullSize / 8)
+		}
+		if int64(len(hashes)) <= threshold {
+			for i, x := range hashes {
+				hashes[i] = unmapPostgresHash(x, params.Log2m)
+			}
+			sort.Slice(hashes, func(i, j int) bool { return int64(hashes[i]) < int64(hashes[j]) })
+			b[0] |= postgresExplicit
+			b = append(b, make([]byte, 8*len(hashes))...)
+			for i, x := range hashes {
+				binary.BigEndian.PutUint64(b[3+8*i:], x)
+			}
+			return b, nil
+		}
+		d = make(Dense, len(h)-8)
+		mergeIntoDense(d, sparse(h))
+	}
+
+	maxValue := byte(1)<<uint(params.Regwidth) - 1
+	register := func(i int) byte {
+		v := d.get(i)
+		if v > maxValue {
+			v = maxValue
+		}
+		return v
+	}
+	n := 0
+	for i := 0; i < m; i++ {
+		if register(i) != 0 {
+			n++
+		}
+	}
+	if n == 0 {
+		b[0] |= postgresEmpty
+		return b, nil
+	}
+	w := params.Log2m + params.Regwidth
+	if sparseSize := (n*w + 7) / 8; params.Sparseon && sparseSize < fullSize {
+		b[0] |= postgresSparse
+		b = append(b, make([]byte, sparseSize)...)
+		pos := 0
+		for i := 0; i < m; i++ {
+			if v := register(i); v != 0 {
+				putBits(b[3:], pos, w, uint64(i)<<uint(params.Regwidth)|uint64(v))
+				pos += w
+			}
+		}
+		return b, nil
+	}
+	b[0] |= postgresFull
+	b = append(b, make([]byte, fullSize)...)
+	for i := 0; i < m; i++ {
+		putBits(b[3:], i*params.Regwidth, params.Regwidth, uint64(register(i)))
+	}
+	return b, nil
+}
+
+// getBits reads width (at most 56) bits starting at bit pos, most significant bit first.
+func getBits(b []byte, pos, width int) uint64 {
+	var w uint64
+	for i, k := pos>>3, 0; k < 8; k++ {
+		w <<= 8
+		if i+k < len(b) {
+			w |= uint64(b[i+k])
+		}
+	}
+	return w << uint(pos&7) >> uint(64-width)
+}
+
+// putBits writes width (at most 56) bits of v starting at bit pos, most significant bit first.
+// The bits must be zero.
+func putBits(b []byte, pos, width int, v uint64) {
+	w := v << uint(64-width) >> uint(pos&7)
+	for i := pos >> 3; w != 0; i++ {
+		b[i] |= byte(w >> 56)
+		w <<= 8
+	}
+}
@@ -0,0 +1,192 @@
+package hll
+
+import (
+	"bytes"
+	"encoding/hex"
+	"log"
+	"math/bits"
+	"testing"
+)
+
+func TestPostgresGolden(t *testing.T) {
+	s, err := SizeByP(11)
+	if err != nil {
+		log.Panicln(err)
+	}
+	params := PostgresParamsByP(11)
+	h := make(HLL, s)
+	b, err := EncodePostgres(h, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// SELECT hll_empty();
+	if hex.EncodeToString(b) != "118b7f" {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	// SELECT hll_add(hll_empty(), hll_hash_integer(1));
+	h.Add(PostgresHash([]byte{1, 0, 0, 0}, 11))
+	b, err = EncodePostgres(h, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b) != "128b7f8895a3f5af28cafe" {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	g, p, err := DecodePostgres(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != params || !bytes.Equal(g, h) {
+		t.Fatal(p, g[:16], h[:16])
+	}
+}
+
+func TestPostgresBits(t *testing.T) {
+	b := make([]byte, 2)
+	for i, v := range []uint64{1, 2, 3} {
+		putBits(b, 5*i, 5, v)
+	}
+	if !bytes.Equal(b, []byte{0x08, 0x86}) {
+		t.Fatalf("%x", b)
+	}
+	for i, v := range []uint64{1, 2, 3} {
+		if getBits(b, 5*i, 5) != v {
+			t.Fatal(i, getBits(b, 5*i, 5))
+		}
+	}
+}
+
+func TestPostgresRoundTrip(t *testing.T) {
+	for _, p := range []int{8, 11, 14} {
+		s, err := SizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		for _, tc := range []struct {
+			n      int
+			params PostgresParams
+			typ    byte
+		}{
+			{0, PostgresParamsByP(p), postgresEmpty},
+			{5, PostgresParamsByP(p), postgresExplicit},
+			{5, PostgresParams{Log2m: p, Regwidth: 6, Expthresh: 0, Sparseon: true}, postgresSparse},
+			{5, PostgresParams{Log2m: p, Regwidth: 4, Expthresh: 4, Sparseon: false}, postgresFull},
+			{1 << uint(p) / 4, PostgresParamsByP(p), postgresSparse},
+			{1 << uint(p) * 4, PostgresParamsByP(p), postgresFull},
+		} {
+			h := make(HLL, s)
+			for i := 0; i < tc.n; i++ {
+				h.Add(xorShift64StarRound(i + 1))
+			}
+			b, err := EncodePostgres(h, tc.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b[0] != 1<<4|tc.typ {
+				t.Fatal(p, tc.n, "unexpected type", b[0])
+			}
+			g, params, err := DecodePostgres(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if params != tc.params {
+				t.Fatal(params, tc.params)
+			}
+			if err := g.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if tc.typ == postgresEmpty || tc.typ == postgresExplicit {
+				if !g.IsSparse() || g.EstimateCardinality() != uint64(tc.n) {
+					t.Fatal(p, tc.n, g.EstimateCardinality())
+				}
+				continue
+			}
+			// Compare the registers.
+			d := make(HLL, s)
+			d[0] = 128 + 64
+			d.Merge(h)
+			maxValue := byte(1)<<uint(tc.params.Regwidth) - 1
+			for i := 0; i < Dense(d[8:]).m(); i++ {
+				v := Dense(d[8:]).get(i)
+				if v > maxValue {
+					v = maxValue
+				}
+				if Dense(g[8:]).get(i) != v {
+					t.Fatal(p, tc.n, i, Dense(g[8:]).get(i), v)
+				}
+			}
+		}
+	}
+}
+
+// TestPostgresSameRegisters checks the registers against the ones postgresql-hll computes for the same hashes.
+func TestPostgresSameRegisters(t *testing.T) {
+	const log2m, regwidth = 12, 5
+	s, err := DenseSizeByP(log2m)
+	if err != nil {
+		log.Panicln(err)
+	}
+	regs := make([]byte, 1<<log2m)
+	d := make(Dense, s)
+	for i := 0; i < 20000; i++ {
+		x := xorShift64StarRound(i + 1)
+		d.Add(RemapPostgresHash(x, log2m))
+		idx := x & (1<<log2m - 1)
+		pw := byte(bits.TrailingZeros64(x>>log2m)) + 1
+		if pw > 1<<regwidth-1 {
+			pw = 1<<regwidth - 1
+		}
+		if regs[idx] < pw {
+			regs[idx] = pw
+		}
+	}
+	b := []byte{0x14, (regwidth-1)<<5 | log2m, 0x7f}
+	b = append(b, make([]byte, len(regs)*regwidth/8)...)
+	for i, v := range regs {
+		putBits(b[3:], i*regwidth, regwidth, uint64(v))
+	}
+	h, _, err := DecodePostgres(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(h[8:], d) {
+		t.Fatal("registers differ")
+	}
+	for i := 0; i < 100; i++ {
+		x := xorShift64StarRound(i)
+		if unmapPostgresHash(RemapPostgresHash(x, log2m), log2m) != x {
+			t.Fatal(x)
+		}
+	}
+}
+
+func TestDecodePostgresErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"118b",
+		"218b7f",               // Version.
+		"108b7f",               // Undefined.
+		"158b7f",               // Unknown type.
+		"118bff",               // Reserved bit.
+		"119e7f",               // log2m = 30.
+		"118b7f00",             // Empty with data.
+		"128b7f8895a3f5af28ca", // Partial hash.
+		"148b7f00",             // Full, wrong size.
+	} {
+		b, _ := hex.DecodeString(s)
+		if _, _, err := DecodePostgres(b); err == nil {
+			t.Fatal("expected error for", s)
+		}
+	}
+	// Register 2047 can not be above 54.
+	b := []byte{0x13, 7<<5 | 11, 0x7f, 0, 0, 0}
+	putBits(b[3:], 0, 19, 2047<<8|54)
+	if _, _, err := DecodePostgres(b); err != nil {
+		t.Fatal(err)
+	}
+	b = []byte{0x13, 7<<5 | 11, 0x7f, 0, 0, 0}
+	putBits(b[3:], 0, 19, 2047<<8|55)
+	if _, _, err := DecodePostgres(b); err == nil {
+		t.Fatal("expected error")
+	}
+}
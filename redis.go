@@ -0,0 +1,273 @@
+package hll
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// Redis HyperLogLog (PFADD/PFCOUNT/PFMERGE) interoperability.
+//
+// Redis keeps an HLL with 2^14 6-bit registers in a string:
+//
+//	4 bytes: magic "HYLL".
+//	1 byte: encoding, 0 dense, 1 sparse.
+//	3 bytes: unused, 0.
+//	8 bytes: cached cardinality (little endian), the highest bit is set if the cache is stale.
+//
+// Dense registers are packed from the least significant bit of each byte.
+// Sparse registers are run-length encoded with three opcodes:
+// ZERO (00xxxxxx, 1-64 zero registers), XZERO (01xxxxxx xxxxxxxx, 1-16384 zero registers)
+// and VAL (1vvvvvxx, 1-4 registers with value 1-32).
+//
+// Redis takes the register index from the low 14 bits of the hash, and the register value
+// from the trailing zeros of the remaining 50 bits, while go-hll counts leading zeros.
+// RemapRedisHash converts a Redis hash, so both put it into the same register:
+// a Dense (or HLL) with p = 14 fed with remapped hashes has the same registers as the Redis key,
+// the two can be merged and estimate the same cardinality (go-hll estimate is exact while it is sparse).
+
+// RedisP is the precision of Redis HLLs.
+const RedisP = 14
+
+const (
+	redisHeaderSize     = 16
+	redisRegisters      = 1 << RedisP
+	redisDenseSize      = redisHeaderSize + redisRegisters*6/8
+	redisMaxRegister    = 64 - RedisP + 1
+	redisMaxSparseValue = 32
+	// RedisSparseMaxBytes is the default hll-sparse-max-bytes: Redis converts larger sparse HLLs to dense.
+	RedisSparseMaxBytes = 3000
+	redisSeed           = 0xadc83b19
+)
+
+var redisMagic = []byte("HYLL")
+
+// RemapRedisHash converts a hash computed the way Redis does (see RedisHash) into a go-hll hash,
+// keeping the register index and value.
+// The only exception is a hash with the top 50 bits zero (Redis puts 51 into its register, go-hll a larger value).
+func RemapRedisHash(hash uint64) uint64 {
+	return bits.Reverse64(hash>>RedisP) | hash&(redisRegisters-1)
+}
+
+// RedisHash returns the hash of an element as PFADD computes it (MurmurHash64A, seed 0xadc83b19),
+// remapped with RemapRedisHash. h.AddRedis(x) is the same as h.Add(RedisHash(x)).
+func RedisHash(b []byte) uint64 {
+	return RemapRedisHash(murmurHash64A(b, redisSeed))
+}
+
+// AddRedis adds an element hashed the way PFADD does (see RedisHash).
+func (h HLL) AddRedis(b []byte) {
+	h.Add(RedisHash(b))
+}
+
+// AddRedis adds an element hashed the way PFADD does (see RedisHash).
+// Returns true if cardinality esimate changed.
+func (h Dense) AddRedis(b []byte) bool {
+	return h.Add(RedisHash(b))
+}
+
+// murmurHash64A is MurmurHash64A (little endian), as used by Redis.
+func murmurHash64A(b []byte, seed uint64) uint64 {
+	const (
+		m = 0xc6a4a7935bd1e995
+		r = 47
+	)
+	h := seed ^ uint64(len(b))*m
+	for ; len(b) >= 8; b = b[8:] {
+		k := binary.LittleEndian.Uint64(b)
+		k *= m
+		k ^= k >> r
+		k *= m
+		h ^= k
+		h *= m
+	}
+	if len(b) > 0 {
+		for i := len(b) - 1; i >= 0; i-- {
+			h ^= uint64(b[i]) << (8 * uint(i))
+		}
+		h *= m
+	}
+	h ^= h >> r
+	h *= m
+	h ^= h >> r
+	return h
+}
+
+// DecodeRedis converts a Redis HLL (the value of a PFADD key, as returned by GET) into a new dense HLL with p = 14.
+func DecodeRedis(b []byte) (HLL, error) {
+	s, _ := SizeByP(RedisP)
+	h := make(HLL, s)
+	if err := decodeRedis(Dense(h[8:]), b); err != nil {
+		return nil, err
+	}
+	h[0] = 128 + 64 // dirty + dense
+	return h, nil
+}
+
+// DecodeRedisDense converts a Redis HLL into a new Dense with p = 14.
+func DecodeRedisDense(b []byte) (Dense, error) {
+	s, _ := DenseSizeByP(RedisP)
+	h := make(Dense, s)
+	if err := decodeRedis(h, b); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func decodeRedis(h Dense, b []byte) error {
+	if len(b) < redisHeaderSize || !bytes.Equal(b[:4], redisMagic) {
+		return errors.New("not a redis hll")
+	}
+	switch b[4] {
+	case 0:
+		if len(b) != redisDenseSize {
+			return errors.New("redis dense hll has wrong size")
+		}
+		r := b[redisHeaderSize:]
+		for i := 0; i < redisRegisters; i++ {
+			v := redisGet(r, i)
+			if v > redisMaxRegister {
+				return errors.New("redis hll register is out of range")
+			}
+			h.set(i, v)
+		}
+		return nil
+	case 1:
+		idx := 0
+		for r := b[redisHeaderSize:]; len(r) > 0; r = r[1:] {
+			op := r[0]
+			switch {
+			case op&0xc0 == 0: // ZERO
+				idx += int(op&0x3f) + 1
+			case op&0xc0 == 0x40: // XZERO
+				if len(r) < 2 {
+					return errors.New("redis sparse hll is truncated")
+				}
+				idx += int(op&0x3f)<<8 | int(r[1]) + 1
+				r = r[1:]
+			default: // VAL
+				v := (op>>2)&0x1f + 1
+				n := int(op&3) + 1
+				if idx+n > redisRegisters {
+					return errors.New("redis sparse hll has too many registers")
+				}
+				for k := 0; k < n; k++ {
+					h.set(idx+k, v)
+				}
+				idx += n
+			}
+			if idx > redisRegisters {
+				return errors.New("redis sparse hll has too many registers")
+			}
+		}
+		if idx != redisRegisters {
+			return errors.New("redis sparse hll has too few registers")
+		}
+		return nil
+	default:
+		return errors.New("unknown redis hll encoding")
+	}
+}
+
+// EncodeRedis converts an HLL with p = 14 into a Redis HLL, that can be loaded with SET (or RESTORE) and used with PFADD/PFCOUNT/PFMERGE.
+// The encoding is sparse if it fits into RedisSparseMaxBytes, dense otherwise.
+// The cached cardinality is marked as stale, so Redis recomputes it.
+//
+// Register values above 51 can not come from Redis hashes (see RemapRedisHash), they are capped.
+func EncodeRedis(h HLL) ([]byte, error) {
+	if err := h.IsValid(); err != nil {
+		return nil, err
+	}
+	if h.IsStandardLayout() {
+		return nil, errors.New("standard layout is not supported")
+	}
+	if h[0]&(1<<6) != 0 {
+		return EncodeRedisDense(Dense(h[8:]))
+	}
+	d := make(Dense, len(h)-8)
+	mergeIntoDense(d, sparse(h))
+	return EncodeRedisDense(d)
+}
+
+// EncodeRedisDense converts a Dense with p = 14 into a Redis HLL (see EncodeRedis).
+func EncodeRedisDense(h Dense) ([]byte, error) {
+	if err := h.IsValid(); err != nil {
+		return nil, err
+	}
+	if h.p() != RedisP {
+		return nil, errors.New("redis hll must have p = 14")
+	}
+	if b := encodeRedisSparse(h); b != nil {
+		return b, nil
+	}
+	b := make([]byte, redisDenseSize)
+	copy(b, redisMagic)
+	b[15] = 1 << 7 // Stale cardinality.
+	r := b[redisHeaderSize:]
+	for i := 0; i < redisRegisters; i++ {
+		v := h.get(i)
+		if v > redisMaxRegister {
+			v = redisMaxRegister
+		}
+		redisSet(r, i, v)
+	}
+	return b, nil
+}
+
+// encodeRedisSparse returns nil if h does not fit into a sparse Redis HLL.
+func encodeRedisSparse(h Dense) []byte {
+	b := make([]byte, redisHeaderSize, 64)
+	copy(b, redisMagic)
+	b[4] = 1
+	b[15] = 1 << 7 // Stale cardinality.
+	for i := 0; i < redisRegisters; {
+		v := h.get(i)
+		n := 1
+		for i+n < redisRegisters && h.get(i+n) == v {
+			n++
+		}
+		i += n
+		switch {
+		case v > redisMaxSparseValue:
+			return nil
+		case v == 0 && n > 64:
+			b = append(b, 0x40|byte((n-1)>>8), byte(n-1)) // XZERO
+		case v == 0:
+			b = append(b, byte(n-1)) // ZERO
+		default:
+			for ; n > 0; n -= 4 {
+				x := n
+				if x > 4 {
+					x = 4
+				}
+				b = append(b, 0x80|(v-1)<<2|byte(x-1)) // VAL
+			}
+		}
+		if len(b)-redisHeaderSize > RedisSparseMaxBytes {
+			return nil
+		}
+	}
+	return b
+}
+
+// redisGet returns register i of Redis dense registers r.
+func redisGet(r []byte, i int) byte {
+	pos := i * 6
+	b, fb := pos>>3, uint(pos&7)
+	v := uint(r[b]) >> fb
+	if fb > 2 {
+		v |= uint(r[b+1]) << (8 - fb)
+	}
+	return byte(v & 63)
+}
+
+// redisSet sets register i of Redis dense registers r to v.
+func redisSet(r []byte, i int, v byte) {
+	pos := i * 6
+	b, fb := pos>>3, uint(pos&7)
+	r[b] = r[b]&^byte(63<<fb) | v<<fb
+	if fb > 2 {
+		r[b+1] = r[b+1]&^byte(63>>(8-fb)) | v>>(8-fb)
+	}
+}
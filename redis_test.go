@@ -0,0 +1,177 @@
+package hll
+
+import (
+	"bytes"
+	"log"
+	"testing"
+)
+
+// redisAdd adds a raw hash to Redis dense registers r, the way hllPatLen/hllDenseSet do.
+func redisAdd(r []byte, hash uint64) {
+	idx := int(hash & (redisRegisters - 1))
+	hash >>= RedisP
+	hash |= 1 << (64 - RedisP)
+	count := byte(1)
+	for bit := uint64(1); hash&bit == 0; bit <<= 1 {
+		count++
+	}
+	if redisGet(r, idx) < count {
+		redisSet(r, idx, count)
+	}
+}
+
+func TestRedisRegisterPacking(t *testing.T) {
+	r := make([]byte, redisDenseSize-redisHeaderSize)
+	redisSet(r, 0, 1)
+	redisSet(r, 1, 2)
+	redisSet(r, 3, 63)
+	redisSet(r, redisRegisters-1, 51)
+	if !bytes.Equal(r[:4], []byte{0x81, 0x00, 0xfc, 0x00}) {
+		t.Fatalf("%x", r[:4])
+	}
+	if r[len(r)-1] != 51<<2 {
+		t.Fatalf("%x", r[len(r)-1])
+	}
+	for i, v := range map[int]byte{0: 1, 1: 2, 2: 0, 3: 63, redisRegisters - 1: 51} {
+		if redisGet(r, i) != v {
+			t.Fatal(i, redisGet(r, i), v)
+		}
+	}
+}
+
+func TestDecodeRedis(t *testing.T) {
+	// An empty Redis HLL, as created by PFADD.
+	empty := []byte("HYLL\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x7f\xff")
+	h, err := DecodeRedis(empty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.EstimateCardinality() != 0 {
+		t.Fatal(h.EstimateCardinality())
+	}
+
+	// XZERO(100) VAL(3, 2) XZERO(16282).
+	b := append([]byte(nil), empty[:16]...)
+	b = append(b, 0x40, 99, 0x80|2<<2|1, 0x40|byte(16281>>8), byte(16281&0xff))
+	d, err := DecodeRedisDense(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < redisRegisters; i++ {
+		v := d.get(i)
+		if (i == 100 || i == 101) != (v == 3) || v != 0 && v != 3 {
+			t.Fatal(i, v)
+		}
+	}
+
+	for _, bad := range [][]byte{
+		nil,
+		[]byte("HYLL"),
+		[]byte("HYLX\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x7f\xff"),
+		[]byte("HYLL\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x7f\xff"),
+		empty[:17],                           // Truncated XZERO.
+		empty[:16],                           // No registers.
+		append(empty[:18:18], 0x00),          // Too many registers.
+		append(b[:18:18], 0x80|2<<2|1, 0x7f), // Too few registers.
+		make([]byte, redisDenseSize),
+		append([]byte("HYLL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"), make([]byte, 10)...),
+	} {
+		if _, err := DecodeRedis(bad); err == nil {
+			t.Fatalf("expected error for %x", bad)
+		}
+	}
+	dense := make([]byte, redisDenseSize)
+	copy(dense, "HYLL")
+	redisSet(dense[redisHeaderSize:], 1<<13, 52)
+	if _, err := DecodeRedis(dense); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRedisSameRegisters(t *testing.T) {
+	s, err := DenseSizeByP(RedisP)
+	if err != nil {
+		log.Panicln(err)
+	}
+	for _, n := range []int{1, 100, 3000, 100000} {
+		r := make([]byte, redisDenseSize)
+		copy(r, redisMagic)
+		d := make(Dense, s)
+		for i := 0; i < n; i++ {
+			x := xorShift64StarRound(i + 1) // Hashes with the top 50 bits zero (like 0) get different registers.
+			redisAdd(r[redisHeaderSize:], x)
+			d.Add(RemapRedisHash(x))
+		}
+		g, err := DecodeRedisDense(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(g, d) {
+			t.Fatal(n, "registers differ")
+		}
+		if err := g.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestEncodeRedis(t *testing.T) {
+	s, err := SizeByP(RedisP)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	b, err := EncodeRedis(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, []byte("HYLL\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x80\x7f\xff")) {
+		t.Fatalf("%q", b)
+	}
+	for _, n := range []int{1, 10, 1000, 10000, 100000} {
+		h := make(HLL, s)
+		for i := 0; i < n; i++ {
+			h.AddRedis([]byte{byte(i), byte(i >> 8), byte(i >> 16)})
+		}
+		b, err := EncodeRedis(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sparse := b[4] == 1; sparse != (n <= 1000) {
+			t.Fatal(n, "unexpected encoding", b[4])
+		}
+		g, err := DecodeRedis(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := make(HLL, s)
+		d[0] = 128 + 64
+		d.Merge(h)
+		if !bytes.Equal(g[8:], d[8:]) {
+			t.Fatal(n, "registers differ")
+		}
+		if n > 1000 && g.EstimateCardinality() != h.EstimateCardinality() {
+			t.Fatal(n, g.EstimateCardinality(), h.EstimateCardinality())
+		}
+	}
+	s13, _ := SizeByP(13)
+	if _, err := EncodeRedis(make(HLL, s13)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMurmurHash64A(t *testing.T) {
+	if murmurHash64A(nil, 0) != 0 {
+		t.Fatal(murmurHash64A(nil, 0))
+	}
+	// Every tail length.
+	b := []byte("0123456789abcdef")
+	seen := map[uint64]bool{}
+	for i := range b {
+		x := murmurHash64A(b[:i], redisSeed)
+		if seen[x] {
+			t.Fatal(i)
+		}
+		seen[x] = true
+	}
+}
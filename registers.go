@@ -0,0 +1,70 @@
+package hll
+
+import (
+	"errors"
+)
+
+// RegisterHistogram returns the number of registers with each value (0...63).
+// The estimators (see EstimateWith) only need this.
+func (h Dense) RegisterHistogram() (c [maxRho + 1]uint32) {
+	for i := 0; i < len(h); i += 3 {
+		x0, x1, x2 := h[i], h[i+1], h[i+2]
+		c[x0>>2]++
+		c[x1>>2]++
+		c[x2>>2]++
+		c[(x0&3)<<4^(x1&3)<<2^x2&3]++
+	}
+	return c
+}
+
+// NumZeroRegisters returns the number of registers no hash got into (what linear counting is based on).
+func (h Dense) NumZeroRegisters() int {
+	n := 0
+	for i := 0; i < len(h); i += 3 {
+		x0, x1, x2 := h[i], h[i+1], h[i+2]
+		if x0>>2 == 0 {
+			n++
+		}
+		if x1>>2 == 0 {
+			n++
+		}
+		if x2>>2 == 0 {
+			n++
+		}
+		if (x0|x1|x2)&3 == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// AppendRegisters appends the registers (one byte each, 2^p of them) to dst and returns the result.
+// Register i holds the largest rho of the hashes with i in their low p bits (see Dense.Add).
+func (h Dense) AppendRegisters(dst []uint8) []uint8 {
+	for i := 0; i < h.m(); i++ {
+		dst = append(dst, h.get(i))
+	}
+	return dst
+}
+
+// FromRegisters returns a Dense with the given registers (as returned by AppendRegisters or Registers).
+// There must be 2^p of them, each at most 63.
+// Registers from another system only mean the same here if it uses the same index and rho (see Dense.Add);
+// the Decode functions take care of that for the formats they know.
+func FromRegisters(p int, registers []uint8) (Dense, error) {
+	s, err := DenseSizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	if len(registers) != 1<<uint(p) {
+		return nil, errors.New("number of registers must be 2^p")
+	}
+	h := make(Dense, s)
+	for i, v := range registers {
+		if v > maxRho {
+			return nil, errors.New("register value must be at most 63")
+		}
+		h.set(i, v)
+	}
+	return h, nil
+}
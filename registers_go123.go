@@ -0,0 +1,17 @@
+//go:build go1.23
+
+package hll
+
+import "iter"
+
+// Registers returns an iterator over the registers: index and value, in index order.
+// See AppendRegisters.
+func (h Dense) Registers() iter.Seq2[int, uint8] {
+	return func(yield func(int, uint8) bool) {
+		for i := 0; i < h.m(); i++ {
+			if !yield(i, h.get(i)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+//go:build go1.23
+
+package hll
+
+import (
+	"log"
+	"testing"
+)
+
+func TestRegisters(t *testing.T) {
+	s, err := DenseSizeByP(6)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(Dense, s)
+	for i := 0; i < 100; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	n := 0
+	for i, v := range h.Registers() {
+		if i != n || v != h.get(i) {
+			t.Fatal(i, n, v)
+		}
+		n++
+	}
+	if n != 64 {
+		t.Fatal(n)
+	}
+	n = 0
+	for range h.Registers() {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package hll
+
+import (
+	"bytes"
+	"log"
+	"testing"
+)
+
+func TestRegisterHistogram(t *testing.T) {
+	s, err := DenseSizeByP(8)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(Dense, s)
+	if c := h.RegisterHistogram(); c[0] != 256 || h.NumZeroRegisters() != 256 {
+		t.Fatal(c, h.NumZeroRegisters())
+	}
+	var want [64]uint32
+	for i := 0; i < h.m(); i++ {
+		h.set(i, byte(i%64))
+		want[i%64]++
+	}
+	if h.RegisterHistogram() != want {
+		t.Fatal(h.RegisterHistogram())
+	}
+	h.Clear()
+	for i := 0; i < 300; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	c := h.RegisterHistogram()
+	if int(c[0]) != h.NumZeroRegisters() || c[0] == 0 || c[0] == 256 {
+		t.Fatal(c[0], h.NumZeroRegisters())
+	}
+}
+
+func TestFromRegisters(t *testing.T) {
+	s, err := DenseSizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(Dense, s)
+	for i := 0; i < 5000; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+	}
+	regs := h.AppendRegisters([]uint8{42})[1:]
+	if len(regs) != 1024 {
+		t.Fatal(len(regs))
+	}
+	for i, v := range regs {
+		if v != h.get(i) {
+			t.Fatal(i, v)
+		}
+	}
+	g, err := FromRegisters(10, regs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(g, h) {
+		t.Fatal("registers differ")
+	}
+	if _, err := FromRegisters(11, regs); err == nil {
+		t.Fatal("expected an error for a wrong number of registers")
+	}
+	if _, err := FromRegisters(3, regs[:8]); err == nil {
+		t.Fatal("expected an error for p = 3")
+	}
+	regs[7] = 64
+	if _, err := FromRegisters(10, regs); err == nil {
+		t.Fatal("expected an error for a register above 63")
+	}
+}
@@ -0,0 +1,82 @@
+package hll
+
+import (
+	"errors"
+	"sync"
+)
+
+// ShardedHLL is an HLL that is safe for concurrent use, built for high-throughput ingestion.
+// Hashes are striped (by hash) across a number of HLLs, each guarded by its own mutex,
+// so concurrent Adds rarely contend.
+// Snapshot merges the shards with Merge, so it is the same as an HLL built by a single goroutine.
+type ShardedHLL struct {
+	shards []shard
+	size   int
+}
+
+type shard struct {
+	mu sync.Mutex
+	h  HLL
+	_  [32]byte // Keep shards on separate cache lines.
+}
+
+// NewShardedHLL creates an empty ShardedHLL of precision p with n shards.
+// Precision (p) must be between 4 and 25 (inclusive).
+// Each shard takes as much memory as an HLL of precision p.
+func NewShardedHLL(p, n int) (*ShardedHLL, error) {
+	size, err := SizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 {
+		return nil, errors.New("number of shards must be positive")
+	}
+	s := &ShardedHLL{shards: make([]shard, n), size: size}
+	for i := range s.shards {
+		s.shards[i].h = make(HLL, size)
+	}
+	return s, nil
+}
+
+// Add a hash to an HLL.
+func (s *ShardedHLL) Add(hash uint64) {
+	sh := &s.shards[(hash>>32)*uint64(len(s.shards))>>32]
+	sh.mu.Lock()
+	sh.h.Add(hash)
+	sh.mu.Unlock()
+}
+
+// Estimate returns a cardinality estimate: that of Snapshot, without keeping the snapshot.
+func (s *ShardedHLL) Estimate() uint64 {
+	return s.merge().EstimateCardinality()
+}
+
+// Snapshot merges the shards into a new HLL.
+// Shards are locked one at a time, so concurrent Adds might or might not make it into the snapshot.
+func (s *ShardedHLL) Snapshot() HLL {
+	h := s.merge()
+	h.EstimateCardinality() // Sorts sparse HLL, caches the estimate for dense one.
+	return h
+}
+
+// merge returns a new HLL with the shards merged into it, each under its own lock.
+func (s *ShardedHLL) merge() HLL {
+	h := make(HLL, s.size)
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		h.Merge(sh.h)
+		sh.mu.Unlock()
+	}
+	return h
+}
+
+// Reset the HLL.
+func (s *ShardedHLL) Reset() {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		sh.h.Reset()
+		sh.mu.Unlock()
+	}
+}
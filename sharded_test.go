@@ -0,0 +1,66 @@
+package hll
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestShardedHLL(t *testing.T) {
+	for _, n := range []int{0, 10, 300, 100000} {
+		s, err := NewShardedHLL(12, 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		size, _ := SizeByP(12)
+		expected := make(HLL, size)
+		for i := 0; i < n; i++ {
+			expected.Add(xorShift64StarRound(i))
+		}
+		c := expected.EstimateCardinality()
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := g; i < n; i += 4 {
+					s.Add(xorShift64StarRound(i))
+				}
+			}(g)
+		}
+		wg.Wait()
+		if e := s.Estimate(); e != c {
+			t.Fatal(n, e, c)
+		}
+		snap := s.Snapshot()
+		if s.Estimate() != snap.EstimateCardinality() {
+			t.Fatal(n, s.Estimate(), snap.EstimateCardinality())
+		}
+		if !bytes.Equal(snap, expected) {
+			t.Fatal(n, "snapshot differs")
+		}
+		s.Reset()
+		if s.Estimate() != 0 || s.Snapshot().EstimateCardinality() != 0 {
+			t.Fatal("expected empty")
+		}
+	}
+	if _, err := NewShardedHLL(12, 0); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := NewShardedHLL(3, 1); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func BenchmarkAddSharded(b *testing.B) {
+	s, _ := NewShardedHLL(14, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		x := randUint64()
+		for pb.Next() {
+			x++
+			s.Add(xorShift64StarRound(int(x)))
+		}
+	})
+}
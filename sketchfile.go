@@ -0,0 +1,204 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package hll
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// SketchFile is a memory mapped file holding an array of HLLs of the same precision, indexed by slot number.
+//
+// Layout:
+// 64 byte header: magic "GHLLFILE", version (1 byte), precision (1 byte), 6 reserved bytes,
+// number of slots (8 bytes, big endian), 40 reserved bytes.
+// Followed by the slots, SizeByP(p) bytes each.
+//
+// Slot returns an HLL backed by the mapping: all HLL operations work in place, no copying or serialization.
+// SketchFile is not safe for concurrent use (same as HLL).
+type SketchFile struct {
+	f        *os.File
+	data     []byte
+	p        int
+	size     int
+	readOnly bool
+}
+
+const sketchFileHeaderSize = 64
+
+var sketchFileMagic = []byte("GHLLFILE")
+
+// CreateSketchFile creates a new file with n empty HLLs of precision p and maps it read-write.
+// Fails if the file exists.
+func CreateSketchFile(path string, p, n int) (*SketchFile, error) {
+	size, err := SizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, errors.New("number of slots must not be negative")
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0666)
+	if err != nil {
+		return nil, err
+	}
+	var header [sketchFileHeaderSize]byte
+	copy(header[:], sketchFileMagic)
+	header[8] = 1 // Version.
+	header[9] = byte(p)
+	binary.BigEndian.PutUint64(header[16:], uint64(n))
+	if _, err := f.Write(header[:]); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Truncate(int64(sketchFileHeaderSize + n*size)); err != nil {
+		f.Close()
+		return nil, err
+	}
+	s := &SketchFile{f: f, p: p, size: size}
+	if err := s.mmap(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// OpenSketchFile opens and maps an existing file.
+// If readOnly is set the mapping is read-only: HLLs returned by Slot must not be modified,
+// that includes EstimateCardinality on a dirty HLL. Use PeekCardinality instead.
+func OpenSketchFile(path string, readOnly bool) (*SketchFile, error) {
+	flag := os.O_RDWR
+	if readOnly {
+		flag = os.O_RDONLY
+	}
+	f, err := os.OpenFile(path, flag, 0)
+	if err != nil {
+		return nil, err
+	}
+	var header [sketchFileHeaderSize]byte
+	if _, err := f.ReadAt(header[:], 0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if !bytes.Equal(header[:8], sketchFileMagic) {
+		f.Close()
+		return nil, errors.New("bad magic")
+	}
+	if header[8] != 1 {
+		f.Close()
+		return nil, errors.New("unsupported sketch file version")
+	}
+	p := int(header[9])
+	size, err := SizeByP(p)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	n := binary.BigEndian.Uint64(header[16:])
+	if n > uint64(fi.Size()) || fi.Size() < int64(sketchFileHeaderSize)+int64(n)*int64(size) {
+		f.Close()
+		return nil, errors.New("sketch file is truncated")
+	}
+	s := &SketchFile{f: f, p: p, size: size, readOnly: readOnly}
+	if err := s.mmap(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// mmap maps the header and all the slots.
+func (s *SketchFile) mmap() error {
+	var header [sketchFileHeaderSize]byte
+	if _, err := s.f.ReadAt(header[:], 0); err != nil {
+		return err
+	}
+	n := int(binary.BigEndian.Uint64(header[16:]))
+	prot := syscall.PROT_READ | syscall.PROT_WRITE
+	if s.readOnly {
+		prot = syscall.PROT_READ
+	}
+	data, err := syscall.Mmap(int(s.f.Fd()), 0, sketchFileHeaderSize+n*s.size, prot, syscall.MAP_SHARED)
+	if err != nil {
+		return err
+	}
+	s.data = data
+	return nil
+}
+
+// P returns the precision of the HLLs.
+func (s *SketchFile) P() int {
+	return s.p
+}
+
+// Len returns the number of slots.
+func (s *SketchFile) Len() int {
+	return (len(s.data) - sketchFileHeaderSize) / s.size
+}
+
+// Slot returns the HLL in slot i.
+// The HLL is backed by the mapping: it is valid until the next Grow or Close.
+func (s *SketchFile) Slot(i int) (HLL, error) {
+	if i < 0 || i >= s.Len() {
+		return nil, errors.New("slot out of range")
+	}
+	off := sketchFileHeaderSize + i*s.size
+	return HLL(s.data[off : off+s.size : off+s.size]), nil
+}
+
+// Grow extends the file to n slots (if it has fewer), new slots are empty HLLs.
+// The file is remapped: HLLs previously returned by Slot must not be used after Grow.
+func (s *SketchFile) Grow(n int) error {
+	if s.readOnly {
+		return errors.New("sketch file is read-only")
+	}
+	if n <= s.Len() {
+		return nil
+	}
+	if err := s.f.Truncate(int64(sketchFileHeaderSize + n*s.size)); err != nil {
+		return err
+	}
+	// The header is updated after the file is extended, so a crash leaves a valid (shorter) file.
+	binary.BigEndian.PutUint64(s.data[16:], uint64(n))
+	// The old mapping is kept until the new one is in place, so a failed Grow leaves s usable.
+	old := s.data
+	if err := s.mmap(); err != nil {
+		return err
+	}
+	return syscall.Munmap(old)
+}
+
+// Sync flushes the mapping to disk (msync).
+func (s *SketchFile) Sync() error {
+	if s.readOnly {
+		return nil
+	}
+	if s.data == nil {
+		return errors.New("sketch file is closed")
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_MSYNC, uintptr(unsafe.Pointer(&s.data[0])), uintptr(len(s.data)), syscall.MS_SYNC)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// Close unmaps and closes the file. Changes are not synced, call Sync for that.
+// HLLs returned by Slot must not be used after Close.
+func (s *SketchFile) Close() error {
+	err := syscall.Munmap(s.data)
+	s.data = nil
+	if cerr := s.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package hll
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSketchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sketches")
+	f, err := CreateSketchFile(path, 10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CreateSketchFile(path, 10, 3); err == nil {
+		t.Fatal("expected error")
+	}
+	if f.Len() != 3 || f.P() != 10 {
+		t.Fatal(f.Len(), f.P())
+	}
+	for i := 0; i < f.Len(); i++ {
+		h, err := f.Slot(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k := 0; k < (i+1)*1000; k++ {
+			h.AddUint64(uint64(k))
+		}
+		h.EstimateCardinality() // Not dirty, so it can be read from a read-only mapping.
+	}
+	if _, err := f.Slot(3); err == nil {
+		t.Fatal("expected error")
+	}
+	if err := f.Grow(5); err != nil {
+		t.Fatal(err)
+	}
+	h, _ := f.Slot(4)
+	h.AddString("alpha")
+	h.EstimateCardinality()
+	h, _ = f.Slot(3)
+	h.AddString("beta") // Dirty.
+	if err := f.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Sync(); err == nil {
+		t.Fatal("expected error")
+	}
+
+	s, _ := SizeByP(10)
+	expected := make(HLL, s)
+	for _, readOnly := range []bool{true, false} {
+		f, err := OpenSketchFile(path, readOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Len() != 5 || f.P() != 10 {
+			t.Fatal(f.Len(), f.P())
+		}
+		for i := 0; i < 3; i++ {
+			expected.Reset()
+			for k := 0; k < (i+1)*1000; k++ {
+				expected.AddUint64(uint64(k))
+			}
+			h, _ := f.Slot(i)
+			if h.PeekCardinality() != expected.EstimateCardinality() {
+				t.Fatal(i, h.PeekCardinality(), expected.EstimateCardinality())
+			}
+		}
+		for i := 3; i < 5; i++ {
+			if h, _ := f.Slot(i); h.PeekCardinality() != 1 {
+				t.Fatal(i, h.PeekCardinality())
+			}
+		}
+		if err := f.Grow(10); (err == nil) == readOnly {
+			t.Fatal(readOnly, err)
+		}
+		if err := f.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Corrupted files.
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, corrupt := range []func([]byte) []byte{
+		func(b []byte) []byte { return b[:10] },
+		func(b []byte) []byte { return b[:len(b)-1] },
+		func(b []byte) []byte { b[0] = 'X'; return b },
+		func(b []byte) []byte { b[8] = 2; return b },
+		func(b []byte) []byte { b[9] = 3; return b },
+	} {
+		if err := os.WriteFile(path, corrupt(append([]byte(nil), b...)), 0666); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := OpenSketchFile(path, true); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+}
@@ -22,6 +22,9 @@ func (s sparse) EstimateCardinality() int {
 }
 
 func (s sparse) Add(hash uint64) addResult {
+	if s.compressed() {
+		return compressed(s).Add(hash)
+	}
 	sz := s.size()
 	sz++
 	if sz < uint32(len(s))>>3 {
@@ -44,38 +47,41 @@ func (s sparse) Add(hash uint64) addResult {
 	return full
 }
 
-type sortable sparse
-
-func (s sortable) Len() int {
-	return len(s) >> 3
-}
-
-func (s sortable) Swap(i, j int) {
-	i <<= 3
-	j <<= 3
-	for k := 0; k < 8; k++ {
-		s[i], s[j] = s[j], s[i]
-		i++
-		j++
+// forEachHash calls f for every hash until f returns false. Returns false if stopped.
+// Hashes might repeat if s is dirty. A compressed s yields a hash per entry (see compressedHash).
+func (s sparse) forEachHash(f func(hash uint64) bool) bool {
+	if s.compressed() {
+		return compressed(s).forEach(func(e uint32) bool {
+			return f(s.entryHash(e))
+		})
 	}
+	sz := int(s.size())
+	for i := 0; i < sz; i++ {
+		if !f(binary.LittleEndian.Uint64(s[8+8*i:])) {
+			return false
+		}
+	}
+	return true
 }
 
-func (s sortable) Less(i, j int) bool {
-	i <<= 3
-	j <<= 3
-	for k := 0; k < 8; k++ {
-		a := s[i]
-		b := s[j]
-		if a < b {
-			return true
-		}
-		if a > b {
-			return false
+// uniqueEntries returns the sorted entries (see compressed) of s, one per index, without modifying s.
+func (s sparse) uniqueEntries() []uint32 {
+	var es []uint32
+	s.forEachHash(func(hash uint64) bool {
+		es = append(es, s.entry(hash))
+		return true
+	})
+	sort.Slice(es, func(i, j int) bool { return es[i] < es[j] })
+	n := 0
+	for _, e := range es {
+		if n > 0 && e>>6 == es[n-1]>>6 {
+			es[n-1] = e // The largest rho comes last.
+			continue
 		}
-		i++
-		j++
+		es[n] = e
+		n++
 	}
-	return false
+	return es[:n]
 }
 
 type addResult int
@@ -85,51 +91,39 @@ const (
 	full addResult = 1
 )
 
+// size returns the number of hashes: the low 29 bits of the header (see HLL).
 func (s sparse) size() uint32 {
-	return binary.BigEndian.Uint32(s) & ^(uint32(1) << 31)
+	return binary.BigEndian.Uint32(s) & (1<<29 - 1)
 }
 
+// setSize sets the number of hashes and the dirty bit (1<<31), keeping the layout.
 func (s sparse) setSize(sz uint32) {
-	binary.BigEndian.PutUint32(s, sz)
-}
-
-func (s sortable) l() int {
-	return len(s)
+	binary.BigEndian.PutUint32(s, sz|uint32(s[0]&standardFlag)<<24)
 }
 
+// sort sorts the hashes (by bytes, that is as big endian uint64 keys) and removes duplicates. Clears dirty.
 func (s sparse) sort() {
 	sz := s.size()
-	end := sz<<3 + 8
-	t := sortable(s[8:end])
-	sort.Sort(t)
+	t := s[8 : 8+sz<<3]
+	for i := 8; i < len(t); i += 8 {
+		if binary.BigEndian.Uint64(t[i-8:]) > binary.BigEndian.Uint64(t[i:]) {
+			radixSort(t, 56)
+			break
+		}
+	}
 	// Remove dups.
 	to := 0
-	from := 0
-outer:
-	for ; from < len(t); from += 8 {
-		if from == 0 {
-			to += 8
+	var prev uint64
+	for from := 0; from < len(t); from += 8 {
+		x := binary.BigEndian.Uint64(t[from:])
+		if from > 0 && x == prev {
 			continue
 		}
-		prev := from - 8
-		i := from
-		for k := 0; k < 8; k++ {
-			if t[prev] != t[i] {
-				if from == to {
-					to += 8
-					continue outer
-				}
-				j := from
-				for k := 0; k < 8; k++ {
-					t[to] = t[j]
-					j++
-					to++
-				}
-				continue outer
-			}
-			i++
-			prev++
+		if from != to {
+			binary.BigEndian.PutUint64(t[to:], x)
 		}
+		to += 8
+		prev = x
 	}
 	// Clear the slack (having zeroes at the end could make hll compress better).
 	s.setSize(uint32(to >> 3))
@@ -137,3 +131,58 @@ outer:
 		t[i] = 0
 	}
 }
+
+// radixSort sorts 8 byte records by their big endian keys in place (American flag sort), starting from the byte at shift.
+func radixSort(t []byte, shift uint) {
+	n := len(t) >> 3
+	if n < 32 {
+		insertionSort(t)
+		return
+	}
+	var count [256]int
+	for i := 0; i < len(t); i += 8 {
+		count[t[i+int(7-shift/8)]]++
+	}
+	var next, end [256]int
+	sum := 0
+	for d, c := range count {
+		next[d] = sum
+		sum += c
+		end[d] = sum
+	}
+	for d := 0; d < 256; d++ {
+		for next[d] < end[d] {
+			// Cycle the record at next[d] to its bucket, until one for d comes back.
+			x := binary.BigEndian.Uint64(t[next[d]<<3:])
+			for xd := int(byte(x >> shift)); xd != d; xd = int(byte(x >> shift)) {
+				i := next[xd] << 3
+				next[xd]++
+				y := binary.BigEndian.Uint64(t[i:])
+				binary.BigEndian.PutUint64(t[i:], x)
+				x = y
+			}
+			binary.BigEndian.PutUint64(t[next[d]<<3:], x)
+			next[d]++
+		}
+	}
+	if shift == 0 {
+		return
+	}
+	for d, start := 0, 0; d < 256; d++ {
+		if count[d] > 1 {
+			radixSort(t[start<<3:end[d]<<3], shift-8)
+		}
+		start = end[d]
+	}
+}
+
+func insertionSort(t []byte) {
+	for i := 8; i < len(t); i += 8 {
+		x := binary.BigEndian.Uint64(t[i:])
+		j := i
+		for ; j > 0 && binary.BigEndian.Uint64(t[j-8:]) > x; j -= 8 {
+			binary.BigEndian.PutUint64(t[j:], binary.BigEndian.Uint64(t[j-8:]))
+		}
+		binary.BigEndian.PutUint64(t[j:], x)
+	}
+}
@@ -1,6 +1,86 @@
 package hll
 
-import "testing"
+import (
+	"bytes"
+	"encoding/binary"
+	"log"
+	"sort"
+	"testing"
+)
+
+func TestSparseSort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 31, 32, 33, 1000, 5000} {
+		s := make(sparse, 8+8*n)
+		var want []uint64
+		for i := 0; i < n; i++ {
+			x := xorShift64StarRound(i % (n/3 + 1)) // Duplicates.
+			if i%7 == 0 {
+				x &= 0xffff // Common prefixes.
+			}
+			binary.LittleEndian.PutUint64(s[8+8*i:], x)
+			want = append(want, binary.BigEndian.Uint64(s[8+8*i:]))
+		}
+		s.setSize(uint32(n) | 1<<31)
+		s.sort()
+		sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+		k := 0
+		for i, x := range want {
+			if i == 0 || x != want[i-1] {
+				want[k] = x
+				k++
+			}
+		}
+		if s.dirty() || int(s.size()) != k {
+			t.Fatal(n, s.size(), k)
+		}
+		for i, x := range want[:k] {
+			if binary.BigEndian.Uint64(s[8+8*i:]) != x {
+				t.Fatal(n, i)
+			}
+		}
+		for _, b := range s[8+8*k:] {
+			if b != 0 {
+				t.Fatal(n, "slack is not cleared")
+			}
+		}
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	for _, c := range []struct{ a, b, overlap int }{{0, 0, 0}, {0, 5, 0}, {5, 0, 0}, {10, 20, 5}, {20, 10, 10}, {60, 60, 30}, {80, 80, 0}} {
+		a, b, want := make(HLL, s), make(HLL, s), make(HLL, s)
+		want[0] = 128 + 64
+		for i := 0; i < c.a; i++ {
+			a.Add(xorShift64StarRound(i + 1))
+			want.Add(xorShift64StarRound(i + 1))
+		}
+		for i := 0; i < c.b; i++ {
+			b.Add(xorShift64StarRound(i + 1 + c.a - c.overlap))
+			want.Add(xorShift64StarRound(i + 1 + c.a - c.overlap))
+		}
+		b.EstimateCardinality() // Clean, a is still dirty.
+		if err := a.Merge(b); err != nil {
+			t.Fatal(err)
+		}
+		if u := c.a + c.b - c.overlap; 8+8*u <= s {
+			if !a.IsSparse() || sparse(a).dirty() || a.EstimateCardinality() != uint64(u) {
+				t.Fatal(c, a.EstimateCardinality())
+			}
+			if err := a.Validate(); err != nil {
+				t.Fatal(c, err)
+			}
+		} else if a.IsSparse() {
+			t.Fatal(c, "should not fit")
+		}
+		if !bytes.Equal(toDenseHLL(a)[8:], want[8:]) {
+			t.Fatal(c, "registers differ")
+		}
+	}
+}
 
 func BenchmarkSort(b *testing.B) {
 	s, _ := DenseSizeByP(14)
@@ -17,6 +97,115 @@ func BenchmarkSort(b *testing.B) {
 	}
 }
 
+// unsortedSparse returns a dirty sparse (p = 14) with random hashes, almost full.
+func unsortedSparse() sparse {
+	s, _ := DenseSizeByP(14)
+	h := make(sparse, s+8)
+	l := len(h)/8 - 100
+	for i := 0; i <= l; i++ {
+		h.Add(randUint64())
+	}
+	return h
+}
+
+func BenchmarkSortRandom(b *testing.B) {
+	src := unsortedSparse()
+	h := make(sparse, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i <= b.N; i++ {
+		copy(h, src)
+		h.sort()
+	}
+}
+
+// bytewise is the former sparse sort: sort.Sort comparing and swapping a byte at a time. Kept as a baseline.
+type bytewise []byte
+
+func (s bytewise) Len() int {
+	return len(s) >> 3
+}
+
+func (s bytewise) Swap(i, j int) {
+	i <<= 3
+	j <<= 3
+	for k := 0; k < 8; k++ {
+		s[i], s[j] = s[j], s[i]
+		i++
+		j++
+	}
+}
+
+func (s bytewise) Less(i, j int) bool {
+	i <<= 3
+	j <<= 3
+	for k := 0; k < 8; k++ {
+		a := s[i]
+		b := s[j]
+		if a < b {
+			return true
+		}
+		if a > b {
+			return false
+		}
+		i++
+		j++
+	}
+	return false
+}
+
+func BenchmarkSortRandomBytewise(b *testing.B) {
+	src := unsortedSparse()
+	h := make(sparse, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i <= b.N; i++ {
+		copy(h, src)
+		sort.Sort(bytewise(h[8 : 8+8*h.size()]))
+	}
+}
+
+// cleanSparsePair returns two clean sparse HLLs (p = 14) with 700 hashes each, 200 of them shared.
+func cleanSparsePair() (HLL, HLL) {
+	s, _ := SizeByP(14)
+	h, g := make(HLL, s), make(HLL, s)
+	for i := 0; i < 700; i++ {
+		h.Add(xorShift64StarRound(i + 1))
+		g.Add(xorShift64StarRound(i + 501))
+	}
+	h.EstimateCardinality()
+	g.EstimateCardinality()
+	return h, g
+}
+
+func BenchmarkMergeSparse(b *testing.B) {
+	src, g := cleanSparsePair()
+	h := make(HLL, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(h, src)
+		h.Merge(g)
+	}
+}
+
+// BenchmarkMergeSparseReAdd is the former sparse merge: add every hash, then sort. Kept as a baseline.
+func BenchmarkMergeSparseReAdd(b *testing.B) {
+	src, g := cleanSparsePair()
+	h := make(HLL, len(src))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(h, src)
+		s := sparse(h)
+		sparse(g).forEachHash(func(hash uint64) bool {
+			s.Add(hash)
+			return true
+		})
+		sort.Sort(bytewise(s[8 : 8+8*s.size()]))
+	}
+}
+
 func BenchmarkAddSparse(b *testing.B) {
 	s, _ := DenseSizeByP(18)
 	h := make(sparse, s+8)
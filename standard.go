@@ -0,0 +1,158 @@
+package hll
+
+import (
+	"errors"
+
+	"github.com/dgryski/go-bits"
+)
+
+// standardFlag (in h[0]) marks an HLL with the standard layout.
+const standardFlag = 1 << 5
+
+// ErrLayoutMismatch is returned when HLLs of different layouts (see SetStandardLayout) are combined.
+var ErrLayoutMismatch = errors.New("layout mismatch")
+
+// SetStandardLayout switches an HLL to the standard layout, the one most other HLL implementations use:
+// the register index is the top p bits of a hash, the register value (rho) is the number of leading zeros
+// of the other 64-p bits + 1 (at most 65-p).
+// By default the index is the low p bits of a hash and rho counts the leading zeros of the whole hash (see Dense.Add).
+//
+// The layout is recorded in the header and kept by Reset: HLLs of different layouts are never combined (ErrLayoutMismatch).
+// h must be sparse and not compressed (say, empty): the hashes do not depend on the layout, registers and entries do.
+//
+// The estimates need no changes: rho up to 64-p is geometric in both layouts, they only differ for larger values,
+// which have a probability of 2^(p-64). So the bias tables, measured with the default layout (see internal/gentables), apply.
+func (h HLL) SetStandardLayout() error {
+	if h[0]&(1<<6) != 0 || sparse(h).compressed() {
+		return errors.New("layout can only be set for a sparse, not compressed, HLL")
+	}
+	h[0] |= standardFlag
+	return nil
+}
+
+// IsStandardLayout returns true iff the HLL uses the standard layout (see SetStandardLayout).
+func (h HLL) IsStandardLayout() bool {
+	return h[0]&standardFlag != 0
+}
+
+func (s sparse) standard() bool {
+	return s[0]&standardFlag != 0
+}
+
+// AddStandard adds a hash to a Dense with the standard layout (see HLL.SetStandardLayout).
+// Do not mix it with Add on the same Dense, nor Merge Denses of different layouts: unlike HLL, Dense does not record its layout,
+// so that is up to the caller. Dense.Fold and Dense.MergeFolding assume the default layout
+// (HLL.Fold and HLL.MergeFolding handle both).
+// Returns true if cardinality esimate changed.
+func (h Dense) AddStandard(hash uint64) bool {
+	idx, r := h.registerStandard(hash)
+	if h.get(idx) >= r {
+		return false
+	}
+	h.set(idx, r)
+	return true
+}
+
+// registerStandard returns the register index and the value (rho) for a hash in the standard layout.
+func (h Dense) registerStandard(hash uint64) (int, byte) {
+	p := h.p()
+	return int(hash >> (64 - p)), byte(bits.Clz(hash<<p|1<<(p-1)) + 1)
+}
+
+// add a hash in the given layout.
+func (h Dense) add(hash uint64, standard bool) bool {
+	if standard {
+		return h.AddStandard(hash)
+	}
+	return h.Add(hash)
+}
+
+// registerFor returns the register index and the value for a hash in the given layout.
+func (h Dense) registerFor(hash uint64, standard bool) (int, byte) {
+	if standard {
+		return h.registerStandard(hash)
+	}
+	return h.register(hash)
+}
+
+// entry returns the compressed entry (see compressed) for a hash, in the layout of s.
+func (s sparse) entry(hash uint64) uint32 {
+	if s.standard() {
+		return standardEntry(hash)
+	}
+	return compressedEntry(hash)
+}
+
+// entryHash returns a hash with entry e (see compressedHash), in the layout of s.
+func (s sparse) entryHash(e uint32) uint64 {
+	if s.standard() {
+		return standardEntryHash(e)
+	}
+	return compressedHash(e)
+}
+
+// standardEntry returns the entry for a hash in the standard layout: the top 25 bits and rho of the other 39.
+func standardEntry(hash uint64) uint32 {
+	const p = sparsePrecision
+	return uint32(hash>>(64-p))<<6 | uint32(bits.Clz(hash<<p|1<<(p-1))+1)
+}
+
+// standardEntryHash returns a hash with entry e in the standard layout.
+// An entry with rho out of range gets a hash with another entry (see validateCompressed).
+func standardEntryHash(e uint32) uint64 {
+	const p = sparsePrecision
+	idx, rho := uint64(e>>6), e&63
+	hash := idx << (64 - p)
+	if rho >= 1 && rho <= 64-p {
+		hash |= 1 << (64 - p - rho)
+	}
+	return hash
+}
+
+// foldStandard is Fold for the standard layout.
+// Register i of the result takes registers i<<d...(i+1)<<d-1 of h (d is the difference in precision):
+// the low d bits of their index follow the index of the result in the hash, so they count towards rho.
+func (h Dense) foldStandard(p int) (Dense, error) {
+	n, err := DenseSizeByP(p)
+	if err != nil {
+		return nil, err
+	}
+	if n > len(h) {
+		return nil, errors.New("can not fold into a higher precision")
+	}
+	d := uint(int(h.p()) - p)
+	m := n / 3 << 2
+	// Register i is only overwritten after registers up to (i+1)<<d-1 were read.
+	for i := 0; i < m; i++ {
+		var v byte
+		for j := i << d; j < (i+1)<<d; j++ {
+			if x := foldedStandard(j, h.get(j), d); x > v {
+				v = x
+			}
+		}
+		h.set(i, v)
+	}
+	return h[:n], nil
+}
+
+// mergeFoldedStandard merges g (of a higher precision) into h, both in the standard layout.
+func (h Dense) mergeFoldedStandard(g Dense) {
+	d := uint(g.p() - h.p())
+	for i := 0; i < g.m(); i++ {
+		if v := foldedStandard(i, g.get(i), d); v > h.get(i>>d) {
+			h.set(i>>d, v)
+		}
+	}
+}
+
+// foldedStandard returns the value register idx (with value v) has at a precision lower by d, in the standard layout.
+func foldedStandard(idx int, v byte, d uint) byte {
+	if v == 0 {
+		return 0
+	}
+	if low := uint64(idx) & (1<<d - 1); low != 0 {
+		// The leading zeros of the low d bits, + 1.
+		return byte(bits.Clz(low) - (64 - uint64(d)) + 1)
+	}
+	return byte(d) + v
+}
@@ -0,0 +1,337 @@
+package hll
+
+import (
+	"bytes"
+	"log"
+	"math"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func newStandard(p int) HLL {
+	s, err := SizeByP(p)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	if err := h.SetStandardLayout(); err != nil {
+		log.Panicln(err)
+	}
+	return h
+}
+
+func TestSetStandardLayout(t *testing.T) {
+	h := newStandard(10)
+	if !h.IsStandardLayout() {
+		t.Fatal("should be standard")
+	}
+	h.Add(1)
+	h.Reset()
+	if !h.IsStandardLayout() {
+		t.Fatal("Reset should keep the layout")
+	}
+	s, _ := SizeByP(10)
+	c := make(HLL, s)
+	c.CompressSparse()
+	if err := c.SetStandardLayout(); err == nil {
+		t.Fatal("compressed should fail")
+	}
+	d := make(HLL, s)
+	d[0] = 64
+	if err := d.SetStandardLayout(); err == nil {
+		t.Fatal("dense should fail")
+	}
+	if d.IsStandardLayout() {
+		t.Fatal("should be default")
+	}
+}
+
+func TestStandardRegister(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, p := range []int{4, 10, 14, 25} {
+		s, _ := DenseSizeByP(p)
+		h := make(Dense, s)
+		for i := 0; i < 1000; i++ {
+			hash := rnd.Uint64() >> uint(rnd.Intn(64))
+			idx, rho := h.registerStandard(hash)
+			if idx != int(hash>>uint(64-p)) {
+				t.Fatal(p, hash, idx)
+			}
+			want := bits.LeadingZeros64(hash<<uint(p)) + 1
+			if want > 65-p {
+				want = 65 - p
+			}
+			if int(rho) != want {
+				t.Fatal(p, hash, rho, want)
+			}
+		}
+	}
+}
+
+func TestStandardEntry(t *testing.T) {
+	var ds []Dense
+	for _, p := range []int{4, 12, 25} {
+		s, _ := DenseSizeByP(p)
+		ds = append(ds, make(Dense, s))
+	}
+	rnd := rand.New(rand.NewSource(2))
+	for i := 0; i < 1000; i++ {
+		hash := rnd.Uint64() >> uint(rnd.Intn(64))
+		e := standardEntry(hash)
+		if standardEntry(standardEntryHash(e)) != e {
+			t.Fatal(hash, e)
+		}
+		// The registers of an entry agree with those of the hash at every p.
+		for _, h := range ds {
+			p := h.p()
+			idx, rho := h.registerStandard(hash)
+			idx2, rho2 := h.registerStandard(standardEntryHash(e))
+			if idx != idx2 || rho != rho2 {
+				t.Fatal(p, hash, idx, rho, idx2, rho2)
+			}
+		}
+	}
+}
+
+func TestStandardAdd(t *testing.T) {
+	for _, n := range []int{10, 100, 1000, 100000} {
+		for _, compress := range []bool{false, true} {
+			h := newStandard(12)
+			if compress {
+				h.CompressSparse()
+			}
+			s, _ := DenseSizeByP(12)
+			d := make(Dense, s)
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i))
+				d.AddStandard(xorShift64StarRound(i))
+			}
+			if !h.IsStandardLayout() {
+				t.Fatal(n, compress, "layout lost")
+			}
+			if err := h.Validate(); err != nil {
+				t.Fatal(n, compress, err)
+			}
+			if h.IsSparse() {
+				if c := h.EstimateCardinality(); c != uint64(n) {
+					t.Fatal(n, compress, c)
+				}
+				continue
+			}
+			if !bytes.Equal(h[8:], d) {
+				t.Fatal(n, compress, "registers differ")
+			}
+			if c := h.EstimateCardinality(); c != d.EstimateCardinality() || c != h.PeekCardinality() {
+				t.Fatal(n, compress, c, d.EstimateCardinality(), h.PeekCardinality())
+			}
+			if !h.IsStandardLayout() {
+				t.Fatal(n, compress, "the cached estimate should keep the layout")
+			}
+		}
+	}
+}
+
+func TestStandardContains(t *testing.T) {
+	for _, n := range []int{10, 10000} {
+		h := newStandard(10)
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+		}
+		for i := 0; i < n; i++ {
+			if present, _ := h.Contains(xorShift64StarRound(i)); !present {
+				t.Fatal(n, i)
+			}
+		}
+	}
+}
+
+func TestStandardValidate(t *testing.T) {
+	h := newStandard(8)
+	h[0] = 64 | standardFlag
+	// The largest register value of the standard layout: a hash with the low 64-p bits zero.
+	h.Add(1 << 60)
+	if v := Dense(h[8:]).get(1 << 4); v != 57 {
+		t.Fatal(v)
+	}
+	if err := h.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	Dense(h[8:]).set(0, 58)
+	if err := h.Validate(); err == nil {
+		t.Fatal("58 is out of range")
+	}
+}
+
+func TestStandardLayoutMismatch(t *testing.T) {
+	s, _ := SizeByP(10)
+	for _, n := range []int{10, 10000} {
+		h := newStandard(10)
+		g := make(HLL, s)
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+			g.Add(xorShift64StarRound(i))
+		}
+		if err := h.Merge(g); err != ErrLayoutMismatch {
+			t.Fatal(n, err)
+		}
+		if err := g.Merge(h); err != ErrLayoutMismatch {
+			t.Fatal(n, err)
+		}
+		if _, err := h.MergeFolding(g); err != ErrLayoutMismatch {
+			t.Fatal(n, err)
+		}
+		if _, err := UnionEstimate(h, g); err != ErrLayoutMismatch {
+			t.Fatal(n, err)
+		}
+		if _, err := IntersectionEstimate(h, g); err != ErrLayoutMismatch {
+			t.Fatal(n, err)
+		}
+		if _, err := EncodeRedis(h); err == nil {
+			t.Fatal(n, "standard layout should not be encoded")
+		}
+	}
+}
+
+// TestStandardDenseLayoutMismatch checks that dense HLLs of different layouts are not merged:
+// the registers are the same kind of Dense, only the header tells them apart.
+func TestStandardDenseLayoutMismatch(t *testing.T) {
+	s, _ := SizeByP(10)
+	h, g := newStandard(10), make(HLL, s)
+	h[0] |= 64
+	g[0] |= 64
+	for i := 0; i < 1000; i++ {
+		h.Add(xorShift64StarRound(i))
+		g.Add(xorShift64StarRound(i + 500))
+	}
+	h0, g0 := append(HLL(nil), h...), append(HLL(nil), g...)
+	if err := h.Merge(g); err != ErrLayoutMismatch {
+		t.Fatal(err)
+	}
+	if err := g.SafeMerge(h); err != ErrLayoutMismatch {
+		t.Fatal(err)
+	}
+	if _, err := h.MergeFolding(g); err != ErrLayoutMismatch {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(h, h0) || !bytes.Equal(g, g0) {
+		t.Fatal("modified")
+	}
+	// Dense does not know: its Merge takes the registers as they are.
+	d := append(Dense(nil), h[8:]...)
+	if err := d.Merge(Dense(g[8:])); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStandardMerge(t *testing.T) {
+	for _, n := range []int{10, 1000, 100000} {
+		h, g, all := newStandard(10), newStandard(10), newStandard(10)
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+			g.Add(xorShift64StarRound(i + n/2))
+			all.Add(xorShift64StarRound(i))
+			all.Add(xorShift64StarRound(i + n/2))
+		}
+		u, err := UnionEstimate(h, g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := h.Merge(g); err != nil {
+			t.Fatal(err)
+		}
+		if !h.IsStandardLayout() {
+			t.Fatal(n, "layout lost")
+		}
+		if c := h.EstimateCardinality(); c != all.EstimateCardinality() || c != u {
+			t.Fatal(n, c, all.EstimateCardinality(), u)
+		}
+		if _, err := IntersectionEstimate(h, g); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestStandardFold(t *testing.T) {
+	for _, n := range []int{0, 100, 1000, 100000} {
+		for _, sd := range []byte{0, 64} {
+			h := newStandard(14)
+			h[0] |= sd
+			expected := newStandard(8)
+			expected[0] |= sd
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i))
+				expected.Add(xorShift64StarRound(i))
+			}
+			f, err := h.Fold(8)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !f.IsStandardLayout() || len(f) != len(expected) || f.IsSparse() != expected.IsSparse() {
+				t.Fatal(n, sd, f.IsStandardLayout(), len(f), f.IsSparse())
+			}
+			if f.EstimateCardinality() != expected.EstimateCardinality() {
+				t.Fatal(n, sd, f.EstimateCardinality(), expected.EstimateCardinality())
+			}
+			if !f.IsSparse() && !bytes.Equal(f[8:], expected[8:]) {
+				t.Fatal(n, sd, "folded registers differ")
+			}
+		}
+	}
+}
+
+func TestStandardMergeFolding(t *testing.T) {
+	for _, n := range []int{3, 1000, 100000} {
+		for _, ps := range [][2]int{{12, 8}, {8, 12}} {
+			h, g, all := newStandard(ps[0]), newStandard(ps[1]), newStandard(8)
+			h[0] |= 64
+			all[0] |= 64
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i))
+				g.Add(xorShift64StarRound(i + n/2))
+				all.Add(xorShift64StarRound(i))
+				all.Add(xorShift64StarRound(i + n/2))
+			}
+			m, err := h.MergeFolding(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !m.IsStandardLayout() || len(m) != len(all) {
+				t.Fatal(n, ps, m.IsStandardLayout(), len(m))
+			}
+			if !bytes.Equal(m[8:], all[8:]) {
+				t.Fatal(n, ps, "merged registers differ")
+			}
+		}
+	}
+}
+
+// TestStandardAccuracy checks that the estimates (tuned with the default layout) are as good with the standard one.
+func TestStandardAccuracy(t *testing.T) {
+	const trials = 100
+	s, _ := DenseSizeByP(10)
+	h := make(Dense, s)
+	stdErr := 1.04 / math.Sqrt(float64(h.m()))
+	checkpoints := []int{500, 2000, 5000, 20000}
+	var sum, sum2 [4]float64
+	rnd := rand.New(rand.NewSource(3))
+	for k := 0; k < trials; k++ {
+		h.Clear()
+		n := 0
+		for j, c := range checkpoints {
+			for ; n < c; n++ {
+				h.AddStandard(xxHash64Uint64(rnd.Uint64()))
+			}
+			d := float64(h.EstimateCardinality())/float64(n) - 1
+			sum[j] += d
+			sum2[j] += d * d
+		}
+	}
+	for j, c := range checkpoints {
+		bias := sum[j] / trials
+		rmse := math.Sqrt(sum2[j] / trials)
+		if math.Abs(bias) > 3*stdErr/math.Sqrt(trials)+0.01 || rmse > 1.2*stdErr {
+			t.Error(c, bias, rmse, stdErr)
+		}
+	}
+}
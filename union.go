@@ -0,0 +1,137 @@
+package hll
+
+import "sort"
+
+// UnionEstimate returns a cardinality estimate of the union of hlls.
+// Equivalent to merging all of them into a scratch HLL and estimating its cardinality,
+// but no HLL is modified: register-wise maxima are computed in a single pass.
+// All the HLLs must have the same precision and layout.
+//
+// If all the HLLs are sparse (and not compressed) the answer is exact.
+// Sparse HLLs mixed with dense ones need a temporary buffer of 4 bytes per sparse element.
+func UnionEstimate(hlls ...HLL) (uint64, error) {
+	if len(hlls) == 0 {
+		return 0, nil
+	}
+	var dense []Dense
+	n := 0
+	anyCompressed := false
+	for _, h := range hlls {
+		if len(h) != len(hlls[0]) {
+			return 0, ErrSizeMismatch
+		}
+		if (h[0]^hlls[0][0])&standardFlag != 0 {
+			return 0, ErrLayoutMismatch
+		}
+		if h[0]&(1<<6) != 0 {
+			dense = append(dense, Dense(h[8:]))
+		} else if s := sparse(h); s.compressed() {
+			anyCompressed = true
+			n += compressed(s).listSize() + compressed(s).tailSize() // An entry takes at least a byte.
+		} else {
+			n += int(s.size())
+		}
+	}
+	if len(dense) == 0 && anyCompressed {
+		// Compare the entries (see compressed) rather than the hashes.
+		es := make([]uint32, 0, n)
+		for _, h := range hlls {
+			sparse(h).forEachHash(func(hash uint64) bool {
+				es = append(es, sparse(h).entry(hash))
+				return true
+			})
+		}
+		sort.Slice(es, func(i, j int) bool { return es[i] < es[j] })
+		k := 0
+		for i, e := range es {
+			if i == 0 || e>>6 != es[i-1]>>6 {
+				k++
+			}
+		}
+		return compressedEstimate(k), nil
+	}
+	if len(dense) == 0 {
+		hs := make([]uint64, 0, n)
+		for _, h := range hlls {
+			sparse(h).forEachHash(func(hash uint64) bool {
+				hs = append(hs, hash)
+				return true
+			})
+		}
+		sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+		var card uint64
+		for i, h := range hs {
+			if i == 0 || h != hs[i-1] {
+				card++
+			}
+		}
+		return card, nil
+	}
+	// Sparse elements as (index << 6 | rho), sorted by index.
+	regs := make([]uint32, 0, n)
+	standard := hlls[0].IsStandardLayout()
+	for _, h := range hlls {
+		if h[0]&(1<<6) != 0 {
+			continue
+		}
+		sparse(h).forEachHash(func(hash uint64) bool {
+			idx, rho := dense[0].registerFor(hash, standard)
+			regs = append(regs, uint32(idx)<<6|uint32(rho))
+			return true
+		})
+	}
+	sort.Slice(regs, func(i, j int) bool { return regs[i] < regs[j] })
+
+	var V int
+	var invSum float64
+	m := dense[0].m()
+	for i, k := 0, 0; i < m; i++ {
+		var v byte
+		for _, d := range dense {
+			if x := d.get(i); x > v {
+				v = x
+			}
+		}
+		for ; k < len(regs) && int(regs[k]>>6) == i; k++ {
+			if x := byte(regs[k] & 63); x > v {
+				v = x
+			}
+		}
+		invSum += lookup[v]
+		if v == 0 {
+			V++
+		}
+	}
+	return round(correctedEstimate(m, invSum, V)), nil
+}
+
+// DenseUnionEstimate returns a cardinality estimate of the union of hs.
+// Equivalent to merging all of them into a scratch Dense and estimating its cardinality,
+// but nothing is modified or allocated.
+// All the HLLs must have the same precision.
+func DenseUnionEstimate(hs ...Dense) (uint64, error) {
+	if len(hs) == 0 {
+		return 0, nil
+	}
+	for _, h := range hs {
+		if len(h) != len(hs[0]) {
+			return 0, ErrSizeMismatch
+		}
+	}
+	var V int
+	var invSum float64
+	m := hs[0].m()
+	for i := 0; i < m; i++ {
+		var v byte
+		for _, h := range hs {
+			if x := h.get(i); x > v {
+				v = x
+			}
+		}
+		invSum += lookup[v]
+		if v == 0 {
+			V++
+		}
+	}
+	return round(correctedEstimate(m, invSum, V)), nil
+}
@@ -0,0 +1,86 @@
+package hll
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestUnionEstimate(t *testing.T) {
+	s, _ := SizeByP(12)
+	for _, sizes := range [][]int{
+		{},
+		{10},
+		{10, 100, 5},
+		{10, 100000, 5},
+		{3000, 200, 100000, 50000},
+		{100000, 100000},
+	} {
+		var hlls []HLL
+		merged := make(HLL, s)
+		for k, n := range sizes {
+			h := make(HLL, s)
+			for i := 0; i < n; i++ {
+				x := xorShift64StarRound(i + k*1000)
+				h.Add(x)
+				merged.Add(x)
+			}
+			hlls = append(hlls, h)
+		}
+		var copies []HLL
+		for _, h := range hlls {
+			copies = append(copies, append(HLL(nil), h...))
+		}
+		u, err := UnionEstimate(hlls...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u != merged.EstimateCardinality() {
+			t.Fatal(sizes, u, merged.EstimateCardinality())
+		}
+		for i := range hlls {
+			if !bytes.Equal(hlls[i], copies[i]) {
+				t.Fatal("input modified")
+			}
+		}
+		var dense []Dense
+		for _, h := range hlls {
+			if !h.IsSparse() {
+				dense = append(dense, Dense(h[8:]))
+			}
+		}
+		if len(dense) == len(hlls) && len(dense) > 0 {
+			d, err := DenseUnionEstimate(dense...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != u {
+				t.Fatal(d, u)
+			}
+		}
+	}
+	s2, _ := SizeByP(13)
+	if _, err := UnionEstimate(make(HLL, s), make(HLL, s2)); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := DenseUnionEstimate(make(Dense, s-8), make(Dense, s2-8)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func BenchmarkUnionEstimate(b *testing.B) {
+	s, _ := SizeByP(14)
+	var hlls []HLL
+	for k := 0; k < 100; k++ {
+		h := make(HLL, s)
+		h[0] = 64
+		for i := 0; i < 1000; i++ {
+			h.Add(randUint64())
+		}
+		hlls = append(hlls, h)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		UnionEstimate(hlls...)
+	}
+}
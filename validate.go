@@ -0,0 +1,173 @@
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dgryski/go-bits"
+)
+
+// ErrCorrupted is the error all validation failures match (with errors.Is).
+var ErrCorrupted = errors.New("corrupted hll")
+
+// CorruptionError describes why a blob is not a well-formed HLL.
+type CorruptionError struct {
+	Offset int // Byte offset of the offending data, -1 if it is about the blob as a whole.
+	Reason string
+}
+
+func (e *CorruptionError) Error() string {
+	if e.Offset < 0 {
+		return "corrupted hll: " + e.Reason
+	}
+	return fmt.Sprintf("corrupted hll at byte %d: %s", e.Offset, e.Reason)
+}
+
+// Unwrap returns ErrCorrupted.
+func (e *CorruptionError) Unwrap() error {
+	return ErrCorrupted
+}
+
+// Validate performs a deep check of an HLL, so it is safe to use on blobs from untrusted sources.
+// On top of IsValid (the size), it checks that:
+// the reserved header bits of a sparse HLL are zero,
+// hashes of a clean (not dirty) sparse HLL are sorted and have no duplicates,
+// entries of a compressed sparse HLL decode and are in range,
+// dense registers are within range (no hash can put a value above 65 - p into the upper half of the registers).
+// Returns a *CorruptionError. Never modifies the HLL.
+//
+// The cached cardinality estimate of a clean dense HLL is not checked (it can not cause a crash).
+func (h HLL) Validate() error {
+	if err := h.IsValid(); err != nil {
+		return &CorruptionError{Offset: -1, Reason: err.Error()}
+	}
+	if h[0]&(1<<6) != 0 {
+		return validateRegisters(Dense(h[8:]), 8, h.IsStandardLayout())
+	}
+	s := sparse(h)
+	if s.compressed() {
+		return validateCompressed(compressed(s))
+	}
+	if binary.BigEndian.Uint32(s[4:]) != 0 {
+		return &CorruptionError{Offset: 4, Reason: "reserved bits are set"}
+	}
+	if s.dirty() {
+		return nil
+	}
+	sz := int(s.size())
+	for i := 1; i < sz; i++ {
+		// Sorted by bytes (as sparse.sort does), that is big endian.
+		prev, cur := binary.BigEndian.Uint64(s[8*i:]), binary.BigEndian.Uint64(s[8+8*i:])
+		if cur < prev {
+			return &CorruptionError{Offset: 8 + 8*i, Reason: "clean sparse hashes are not sorted"}
+		}
+		if cur == prev {
+			return &CorruptionError{Offset: 8 + 8*i, Reason: "clean sparse hashes have duplicates"}
+		}
+	}
+	return nil
+}
+
+// validateCompressed checks that the list of a compressed sparse HLL decodes exactly, is sorted by index,
+// and that all the entries (tail ones included) could come from a hash.
+func validateCompressed(c compressed) error {
+	s := sparse(c)
+	list := c[8 : 8+c.listSize()]
+	var prev uint32
+	for off := 0; off < len(list); {
+		d, k := binary.Uvarint(list[off:])
+		if k <= 0 || d >= 1<<31 {
+			return &CorruptionError{Offset: 8 + off, Reason: "bad varint in compressed list"}
+		}
+		e := prev + uint32(d)
+		if off > 0 && e>>6 <= prev>>6 {
+			return &CorruptionError{Offset: 8 + off, Reason: "compressed list is not sorted by index"}
+		}
+		if e >= 1<<31 || s.entry(s.entryHash(e)) != e {
+			return &CorruptionError{Offset: 8 + off, Reason: "compressed entry is out of range"}
+		}
+		prev = e
+		off += k
+	}
+	for i := len(c) - 4*c.tailSize(); i < len(c); i += 4 {
+		if e := binary.BigEndian.Uint32(c[i:]); e >= 1<<31 || s.entry(s.entryHash(e)) != e {
+			return &CorruptionError{Offset: i, Reason: "compressed entry is out of range"}
+		}
+	}
+	return nil
+}
+
+// Validate performs a deep check of a Dense HLL: the size (see IsValid) and the register values.
+// Returns a *CorruptionError.
+func (h Dense) Validate() error {
+	if err := h.IsValid(); err != nil {
+		return &CorruptionError{Offset: -1, Reason: err.Error()}
+	}
+	return validateRegisters(h, 0, false)
+}
+
+// validateRegisters checks register values against the largest value a hash can produce (in the given layout).
+func validateRegisters(h Dense, offset int, standard bool) error {
+	p := int(h.p())
+	for i := 0; i < h.m(); i++ {
+		if v, max := h.get(i), maxRegister(p, i, standard); v > max {
+			return &CorruptionError{Offset: offset + i/4*3 + i%4, Reason: fmt.Sprintf("register %d is %d (at most %d)", i, v, max)}
+		}
+	}
+	return nil
+}
+
+// maxRegister returns the largest value a hash can put into register idx of an HLL of precision p (in the given layout).
+// In the default layout register idx is set by hashes with idx in the low bits,
+// so the number of leading zeros is at most 64 - bitlen(idx) (and rho is capped at maxRho).
+// In the standard layout rho counts the leading zeros of the other 64 - p bits.
+func maxRegister(p, idx int, standard bool) byte {
+	if standard {
+		return byte(65 - p)
+	}
+	if idx > 1 {
+		return byte(bits.Clz(uint64(idx)) + 1)
+	}
+	return maxRho
+}
+
+// SafeMerge is Merge for HLLs from untrusted sources: both h and g are validated first.
+// Returns a *CorruptionError (leaving h intact) rather than panicking.
+func (h HLL) SafeMerge(g HLL) error {
+	if err := h.Validate(); err != nil {
+		return err
+	}
+	if err := g.Validate(); err != nil {
+		return err
+	}
+	return h.Merge(g)
+}
+
+// SafeEstimate is EstimateCardinality for an HLL from an untrusted source: the HLL is validated first.
+// Returns a *CorruptionError rather than panicking.
+func (h HLL) SafeEstimate() (uint64, error) {
+	if err := h.Validate(); err != nil {
+		return 0, err
+	}
+	return h.EstimateCardinality(), nil
+}
+
+// SafeMerge is Merge for Dense HLLs from untrusted sources: both h and g are validated first.
+func (h Dense) SafeMerge(g Dense) error {
+	if err := h.Validate(); err != nil {
+		return err
+	}
+	if err := g.Validate(); err != nil {
+		return err
+	}
+	return h.Merge(g)
+}
+
+// SafeEstimate is EstimateCardinality for a Dense HLL from an untrusted source: the HLL is validated first.
+func (h Dense) SafeEstimate() (uint64, error) {
+	if err := h.Validate(); err != nil {
+		return 0, err
+	}
+	return h.EstimateCardinality(), nil
+}
@@ -0,0 +1,147 @@
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"log"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	for _, n := range []int{0, 1, 10, 50, 10000} {
+		h := make(HLL, s)
+		for i := 0; i < n; i++ {
+			h.Add(xorShift64StarRound(i))
+			h.Add(xorShift64StarRound(i))
+		}
+		if err := h.Validate(); err != nil {
+			t.Fatal(n, err)
+		}
+		h.EstimateCardinality()
+		if err := h.Validate(); err != nil {
+			t.Fatal(n, err)
+		}
+		if !h.IsSparse() {
+			if err := Dense(h[8:]).Validate(); err != nil {
+				t.Fatal(n, err)
+			}
+		}
+	}
+	// Largest register values.
+	d := make(Dense, s-8)
+	d.Add(0)
+	d.Add(1)
+	d.Add(1 << 9) // 55 in register 512, the largest possible.
+	if err := d.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	expectCorrupted := func(err error) {
+		t.Helper()
+		var ce *CorruptionError
+		if !errors.As(err, &ce) || !errors.Is(err, ErrCorrupted) {
+			t.Fatal("expected corruption error, got", err)
+		}
+	}
+	expectCorrupted(HLL(make([]byte, 7)).Validate())
+	expectCorrupted(Dense(make([]byte, 13)).Validate())
+
+	h := make(HLL, s)
+	sparse(h).setSize(uint32(s))
+	expectCorrupted(h.Validate())
+
+	h = make(HLL, s)
+	h[5] = 1
+	expectCorrupted(h.Validate())
+
+	// Not sorted.
+	h = make(HLL, s)
+	h.Add(2)
+	h.Add(1)
+	if err := h.Validate(); err != nil {
+		t.Fatal(err) // Dirty, so it is fine.
+	}
+	h[0] &^= 1 << 7
+	expectCorrupted(h.Validate())
+
+	// Duplicates.
+	h = make(HLL, s)
+	h.Add(1)
+	h.Add(1)
+	h[0] &^= 1 << 7
+	expectCorrupted(h.Validate())
+
+	// Register out of range.
+	h = make(HLL, s)
+	h[0] = 128 + 64
+	Dense(h[8:]).set(1<<9, 55)
+	if err := h.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	Dense(h[8:]).set(1<<9, 56)
+	expectCorrupted(h.Validate())
+	expectCorrupted(Dense(h[8:]).Validate())
+}
+
+func TestSafeOperations(t *testing.T) {
+	s, err := SizeByP(8)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	g := make(HLL, s)
+	for i := 0; i < 1000; i++ {
+		g.Add(xorShift64StarRound(i))
+	}
+	if err := h.SafeMerge(g); err != nil {
+		t.Fatal(err)
+	}
+	card, err := h.SafeEstimate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if card != g.EstimateCardinality() {
+		t.Fatal(card, g.EstimateCardinality())
+	}
+
+	// A size that would make Merge read past the end.
+	bad := make(HLL, s)
+	binary.BigEndian.PutUint32(bad, 1<<28)
+	if _, err := bad.SafeEstimate(); !errors.Is(err, ErrCorrupted) {
+		t.Fatal(err)
+	}
+	before := append(HLL(nil), h...)
+	if err := h.SafeMerge(bad); !errors.Is(err, ErrCorrupted) {
+		t.Fatal(err)
+	}
+	if string(before) != string(h) {
+		t.Fatal("modified")
+	}
+	s9, _ := SizeByP(9)
+	if err := h.SafeMerge(make(HLL, s9)); err != ErrSizeMismatch {
+		t.Fatal(err)
+	}
+
+	before = append(HLL(nil), h...)
+	if err := h.Merge(h); err != nil || string(before) != string(h) {
+		t.Fatal("merging into itself", err)
+	}
+
+	d := Dense(h[8:])
+	if err := d.SafeMerge(Dense(g[8:])); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SafeEstimate(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Dense(make([]byte, 10)).SafeEstimate(); !errors.Is(err, ErrCorrupted) {
+		t.Fatal(err)
+	}
+	if err := d.SafeMerge(make(Dense, 10)); !errors.Is(err, ErrCorrupted) {
+		t.Fatal(err)
+	}
+}
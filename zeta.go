@@ -0,0 +1,386 @@
+package hll
+
+import (
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+// ZetaSketch (BigQuery HLL_COUNT) HyperLogLog++ interoperability.
+//
+// A sketch is an AggregatorStateProto:
+//
+//	1: type (112, HYPERLOGLOG_PLUS_UNIQUE).
+//	2: num_values, the number of values added.
+//	3: encoding_version (2).
+//	4: value_type, the type of the values (passed through).
+//	112: HyperLogLogPlusUniqueStateProto:
+//		2: sparse_size, the number of sparse values.
+//		3: precision_or_num_buckets, p.
+//		4: sparse_precision_or_num_buckets, sp.
+//		5: data, a byte per register (normal representation).
+//		6: sparse_data, sorted sparse values, as varint encoded differences.
+//
+// HyperLogLog++ takes the register index from the top p bits of the hash and the register value from the leading zeros
+// of the rest: the standard layout (see SetStandardLayout), which maps as is.
+// For the default layout, RemapZetaHash (a rotation) converts a hash, so go-hll puts it into the same register.
+// A sparse value is the top sp bits of the hash; if the sp - p bits after the normal index are all zero,
+// it is the normal index and the register value of the bits after the sparse index, with a flag bit.
+// Sparse values map to a sparse HLL (a go-hll hash for each), the normal representation to a dense one.
+
+// ZetaParams are the parts of a ZetaSketch that are not registers.
+type ZetaParams struct {
+	SparsePrecision int   // Precision of the sparse representation, p to 25. EncodeZeta uses p + 5 (up to 25) if 0.
+	ValueType       int32 // Type of the values (the DefaultOpsType id). BigQuery refuses to merge sketches of different types.
+	NumValues       int64 // Number of values added. EncodeZeta writes the cardinality estimate if 0.
+}
+
+const (
+	zetaType            = 112
+	zetaEncodingVersion = 2
+	zetaStateField      = 112
+	zetaMinP            = 10
+	zetaMaxP            = 24
+	zetaMaxSparseP      = 25
+	zetaRhoBits         = 6
+)
+
+// RemapZetaHash converts a HyperLogLog++ (ZetaSketch) hash into a go-hll hash for an HLL with precision p,
+// keeping the register index and value.
+// The only exception is a hash with the low 64 - p bits zero.
+func RemapZetaHash(hash uint64, p int) uint64 {
+	return bits.RotateLeft64(hash, p)
+}
+
+// zetaFlag returns the flag of sparse values with the register value encoded.
+func zetaFlag(p, sp int) uint32 {
+	if sp > p+zetaRhoBits {
+		return 1 << uint(sp)
+	}
+	return 1 << uint(p+zetaRhoBits)
+}
+
+// zetaSparseValue returns the sparse value for a ZetaSketch hash.
+func zetaSparseValue(hash uint64, p, sp int) uint32 {
+	idx := uint32(hash >> uint(64-sp))
+	if idx&(1<<uint(sp-p)-1) != 0 {
+		return idx
+	}
+	w := hash << uint(sp)
+	rhoW := uint32(bits.LeadingZeros64(w)) + 1
+	if w == 0 {
+		rhoW = uint32(64-sp) + 1
+	}
+	return zetaFlag(p, sp) | idx>>uint(sp-p)<<zetaRhoBits | rhoW
+}
+
+// zetaSparseHash returns a ZetaSketch hash with sparse value v.
+func zetaSparseHash(v uint32, p, sp int) (uint64, error) {
+	flag := zetaFlag(p, sp)
+	if v&flag == 0 {
+		if v >= 1<<uint(sp) {
+			return 0, errors.New("zeta sparse index is out of range")
+		}
+		return uint64(v) << uint(64-sp), nil
+	}
+	v &^= flag
+	idx, rhoW := uint64(v>>zetaRhoBits), int(v&(1<<zetaRhoBits-1))
+	if idx >= 1<<uint(p) || rhoW == 0 || rhoW > 64-sp+1 {
+		return 0, errors.New("zeta sparse value is out of range")
+	}
+	hash := idx << uint(64-p)
+	if rhoW <= 64-sp {
+		hash |= 1 << uint(64-sp-rhoW)
+	}
+	return hash, nil
+}
+
+// DecodeZeta converts a serialized ZetaSketch HLL++ aggregator state into a new HLL (of the normal precision),
+// returning the other parameters as well.
+// The HLL has the default layout: add RemapZetaHash hashes to it.
+func DecodeZeta(b []byte) (HLL, ZetaParams, error) {
+	return decodeZeta(b, false)
+}
+
+// DecodeZetaStandard is DecodeZeta into an HLL with the standard layout (see SetStandardLayout):
+// ZetaSketch hashes are added as they are.
+func DecodeZetaStandard(b []byte) (HLL, ZetaParams, error) {
+	return decodeZeta(b, true)
+}
+
+func decodeZeta(b []byte, standard bool) (HLL, ZetaParams, error) {
+	var params ZetaParams
+	var state []byte
+	typ := uint64(0)
+	err := protoFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			typ = v
+		case 2:
+			params.NumValues = int64(v)
+		case 4:
+			params.ValueType = int32(v)
+		case zetaStateField:
+			state = data
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, params, err
+	}
+	if typ != zetaType {
+		return nil, params, errors.New("not a zeta hll++ sketch")
+	}
+	if state == nil {
+		return nil, params, errors.New("zeta sketch has no hll++ state")
+	}
+	var p, sparseSize int
+	var data, sparseData []byte
+	hasData, hasSparse := false, false
+	err = protoFields(state, func(field int, v uint64, b []byte) error {
+		switch field {
+		case 2:
+			sparseSize = int(v)
+		case 3:
+			p = int(v)
+		case 4:
+			params.SparsePrecision = int(v)
+		case 5:
+			data, hasData = b, true
+		case 6:
+			sparseData, hasSparse = b, true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, params, err
+	}
+	sp := params.SparsePrecision
+	if p < zetaMinP || p > zetaMaxP {
+		return nil, params, errors.New("zeta precision must be between 10 and 24, inclusive")
+	}
+	if hasSparse && (sp < p || sp > zetaMaxSparseP) {
+		return nil, params, errors.New("zeta sparse precision is out of range")
+	}
+	if hasData && hasSparse {
+		return nil, params, errors.New("zeta sketch has both representations")
+	}
+	s, _ := SizeByP(p)
+	h := make(HLL, s)
+	if standard {
+		h.SetStandardLayout()
+	}
+	if hasData {
+		if len(data) != 1<<uint(p) {
+			return nil, params, errors.New("zeta registers have wrong size")
+		}
+		d := Dense(h[8:])
+		for i, v := range data {
+			if v > 64-byte(p)+1 {
+				return nil, params, errors.New("zeta register is out of range")
+			}
+			d.set(i, v)
+		}
+		h[0] |= 128 + 64 // dirty + dense
+		return h, params, nil
+	}
+	var prev uint64
+	n := 0
+	for len(sparseData) > 0 {
+		diff, k := protoVarint(sparseData)
+		if k == 0 {
+			return nil, params, errors.New("zeta sparse data is truncated")
+		}
+		sparseData = sparseData[k:]
+		prev += diff
+		if prev >= 1<<31 {
+			return nil, params, errors.New("zeta sparse value is out of range")
+		}
+		hash, err := zetaSparseHash(uint32(prev), p, sp)
+		if err != nil {
+			return nil, params, err
+		}
+		if !standard {
+			hash = RemapZetaHash(hash, p)
+		}
+		h.Add(hash)
+		n++
+	}
+	if n != sparseSize {
+		return nil, params, errors.New("zeta sparse size does not match the data")
+	}
+	h.EstimateCardinality() // Sort.
+	return h, params, nil
+}
+
+// EncodeZeta converts an HLL (with p between 10 and 24, either layout) into a ZetaSketch HLL++ aggregator state.
+// A sparse HLL is written in the sparse representation, if it is small enough (3/4 of the normal one),
+// a dense one in the normal representation.
+func EncodeZeta(h HLL, params ZetaParams) ([]byte, error) {
+	if err := h.IsValid(); err != nil {
+		return nil, err
+	}
+	d := Dense(h[8:])
+	p := int(d.p())
+	if p < zetaMinP || p > zetaMaxP {
+		return nil, errors.New("zeta precision must be between 10 and 24, inclusive")
+	}
+	sp := params.SparsePrecision
+	if sp == 0 {
+		sp = p + 5
+		if sp > zetaMaxSparseP {
+			sp = zetaMaxSparseP
+		}
+	}
+	if sp < p || sp > zetaMaxSparseP {
+		return nil, errors.New("zeta sparse precision is out of range")
+	}
+	numValues := params.NumValues
+	if numValues == 0 {
+		numValues = int64(h.PeekCardinality())
+	}
+
+	var state []byte
+	if h[0]&(1<<6) == 0 {
+		hashes := sparse(h).uniqueHashes()
+		values := make([]uint32, len(hashes))
+		for i, x := range hashes {
+			if !h.IsStandardLayout() {
+				x = bits.RotateLeft64(x, -p)
+			}
+			values[i] = zetaSparseValue(x, p, sp)
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		// Keep the largest of the values with the same sparse index (or the same normal index and a register value).
+		n := 0
+		flag := zetaFlag(p, sp)
+		for _, v := range values {
+			if n > 0 && (v == values[n-1] || v&flag != 0 && v>>zetaRhoBits == values[n-1]>>zetaRhoBits) {
+				values[n-1] = v
+				continue
+			}
+			values[n] = v
+			n++
+		}
+		values = values[:n]
+		var sparseData []byte
+		var prev uint32
+		for _, v := range values {
+			sparseData = appendProtoVarint(sparseData, uint64(v-prev))
+			prev = v
+		}
+		if len(sparseData) <= 3<<uint(p)/4 {
+			if len(values) > 0 {
+				state = appendProtoVarintField(state, 2, uint64(len(values)))
+			}
+			state = appendProtoVarintField(state, 3, uint64(p))
+			state = appendProtoVarintField(state, 4, uint64(sp))
+			if len(values) > 0 {
+				state = appendProtoBytesField(state, 6, sparseData)
+			}
+		} else {
+			d = make(Dense, len(h)-8)
+			mergeIntoDense(d, sparse(h))
+		}
+	}
+	if state == nil {
+		data := make([]byte, d.m())
+		for i := range data {
+			v := d.get(i)
+			if v > 64-byte(p)+1 {
+				v = 64 - byte(p) + 1
+			}
+			data[i] = v
+		}
+		state = appendProtoVarintField(state, 3, uint64(p))
+		state = appendProtoVarintField(state, 4, uint64(sp))
+		state = appendProtoBytesField(state, 5, data)
+	}
+	var b []byte
+	b = appendProtoVarintField(b, 1, zetaType)
+	b = appendProtoVarintField(b, 2, uint64(numValues))
+	b = appendProtoVarintField(b, 3, zetaEncodingVersion)
+	b = appendProtoVarintField(b, 4, uint64(int64(params.ValueType)))
+	b = appendProtoBytesField(b, zetaStateField, state)
+	return b, nil
+}
+
+// Protocol buffers wire format, just enough for the messages above.
+
+// protoVarint decodes a varint, returning the value and the number of bytes read (0 on error).
+func protoVarint(b []byte) (uint64, int) {
+	var x uint64
+	for i := 0; i < len(b) && i < 10; i++ {
+		x |= uint64(b[i]&0x7f) << (7 * uint(i))
+		if b[i] < 0x80 {
+			return x, i + 1
+		}
+	}
+	return 0, 0
+}
+
+func appendProtoVarint(b []byte, x uint64) []byte {
+	for x >= 0x80 {
+		b = append(b, byte(x)|0x80)
+		x >>= 7
+	}
+	return append(b, byte(x))
+}
+
+func appendProtoVarintField(b []byte, field int, x uint64) []byte {
+	b = appendProtoVarint(b, uint64(field)<<3)
+	return appendProtoVarint(b, x)
+}
+
+func appendProtoBytesField(b []byte, field int, data []byte) []byte {
+	b = appendProtoVarint(b, uint64(field)<<3|2)
+	b = appendProtoVarint(b, uint64(len(data)))
+	return append(b, data...)
+}
+
+// protoFields calls f for every field of message b: with the value for varints, with the data for length-delimited fields.
+// Fixed size fields are skipped.
+func protoFields(b []byte, f func(field int, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		tag, k := protoVarint(b)
+		if k == 0 || tag>>3 == 0 || tag>>3 > 1<<29 {
+			return errors.New("bad protobuf tag")
+		}
+		b = b[k:]
+		field := int(tag >> 3)
+		switch tag & 7 {
+		case 0:
+			v, k := protoVarint(b)
+			if k == 0 {
+				return errors.New("protobuf varint is truncated")
+			}
+			b = b[k:]
+			if err := f(field, v, nil); err != nil {
+				return err
+			}
+		case 1:
+			if len(b) < 8 {
+				return errors.New("protobuf field is truncated")
+			}
+			b = b[8:]
+		case 2:
+			n, k := protoVarint(b)
+			if k == 0 || n > uint64(len(b)-k) {
+				return errors.New("protobuf field is truncated")
+			}
+			data := b[k : k+int(n) : k+int(n)]
+			b = b[k+int(n):]
+			if err := f(field, 0, data); err != nil {
+				return err
+			}
+		case 5:
+			if len(b) < 4 {
+				return errors.New("protobuf field is truncated")
+			}
+			b = b[4:]
+		default:
+			return errors.New("unsupported protobuf wire type")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package hll
+
+import (
+	"bytes"
+	"encoding/hex"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The golden vectors are NOT output of BigQuery or zetasketch: they are derived by hand
+// from the message definitions (see zeta.go). Real sketches go to testdata/zetasketch (see TestZetaRealSketches).
+
+const zetaGoldenSparse = "0870" + "1002" + "1802" + "2000" + // type, num_values, encoding_version, value_type.
+	"8207" + "0e" + // Field 112, 14 bytes.
+	"1002" + "180a" + "200f" + // sparse_size, p, sp.
+	"3206" + "818001" + "c38003" // sparse_data: 16385, 65604 - 16385.
+
+func TestZetaGoldenSparse(t *testing.T) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	// Sparse index (15 bits) 100000000000001: normal index 512, a plain sparse value (16385), 4 zeros after the normal index.
+	h.Add(RemapZetaHash(0x8002000000000000, 10))
+	// Sparse index 000000000100000: normal index 1, the next 5 bits are zero, then 3 zeros and a one (rhoW = 4).
+	// Flagged sparse value: 1<<16 | 1<<6 | 4 = 65604.
+	h.Add(RemapZetaHash(0x0040200000000000, 10))
+	b, err := EncodeZeta(h, ZetaParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b) != zetaGoldenSparse {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	g, params, err := DecodeZeta(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params != (ZetaParams{SparsePrecision: 15, NumValues: 2}) {
+		t.Fatal(params)
+	}
+	if c, err := EncodeZeta(g, params); err != nil || !bytes.Equal(c, b) {
+		t.Fatal("round trip differs", err)
+	}
+	if !g.IsSparse() || g.EstimateCardinality() != 2 {
+		t.Fatal(g.EstimateCardinality())
+	}
+	d := Dense(toDenseHLL(g)[8:])
+	if d.get(512) != 5 || d.get(1) != 9 {
+		t.Fatal(d.get(512), d.get(1))
+	}
+	if !bytes.Equal(toDenseHLL(g), toDenseHLL(h)) {
+		t.Fatal("registers differ")
+	}
+}
+
+func TestZetaGoldenDense(t *testing.T) {
+	s, err := SizeByP(10)
+	if err != nil {
+		log.Panicln(err)
+	}
+	h := make(HLL, s)
+	h[0] = 128 + 64
+	Dense(h[8:]).set(0, 3)
+	Dense(h[8:]).set(1023, 55)
+	b, err := EncodeZeta(h, ZetaParams{SparsePrecision: 20, ValueType: -1, NumValues: 300})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const prefix = "0870" + "10ac02" + "1802" + "20ffffffffffffffffff01" + // value_type -1 takes 10 bytes.
+		"8207" + "8708" + // 1031 bytes.
+		"180a" + "2014" + "2a8008" + "03" // p, sp, data (1024 bytes).
+	if hex.EncodeToString(b[:len(prefix)/2]) != prefix || len(b) != len(prefix)/2+1023 || b[len(b)-1] != 55 {
+		t.Fatal(hex.EncodeToString(b[:len(prefix)/2]), len(b))
+	}
+	g, params, err := DecodeZeta(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params != (ZetaParams{SparsePrecision: 20, ValueType: -1, NumValues: 300}) {
+		t.Fatal(params)
+	}
+	if d := Dense(g[8:]); !bytes.Equal(g[8:], h[8:]) || d.get(0) != 3 || d.get(1023) != 55 || d.NumZeroRegisters() != 1022 {
+		t.Fatal("registers differ")
+	}
+	if c, err := EncodeZeta(g, params); err != nil || !bytes.Equal(c, b) {
+		t.Fatal("round trip differs", err)
+	}
+}
+
+// TestZetaGoldenStandard checks that the standard layout takes ZetaSketch hashes and registers as they are.
+func TestZetaGoldenStandard(t *testing.T) {
+	h := newStandard(10)
+	h.Add(0x8002000000000000)
+	h.Add(0x0040200000000000)
+	b, err := EncodeZeta(h, ZetaParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b) != zetaGoldenSparse {
+		t.Fatal(hex.EncodeToString(b))
+	}
+	g, _, err := DecodeZetaStandard(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsStandardLayout() || !g.IsSparse() || g.EstimateCardinality() != 2 {
+		t.Fatal(g.IsStandardLayout(), g.EstimateCardinality())
+	}
+	d := Dense(toDenseHLL(g)[8:])
+	if d.get(512) != 5 || d.get(1) != 9 {
+		t.Fatal(d.get(512), d.get(1))
+	}
+
+	// The registers of TestZetaGoldenDense.
+	h = newStandard(10)
+	h[0] |= 128 + 64
+	Dense(h[8:]).set(0, 3)
+	Dense(h[8:]).set(1023, 55)
+	def := make(HLL, len(h))
+	def[0] = 128 + 64
+	copy(def[8:], h[8:])
+	params := ZetaParams{SparsePrecision: 20, ValueType: -1, NumValues: 300}
+	b, err = EncodeZeta(h, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if golden, _ := EncodeZeta(def, params); !bytes.Equal(b, golden) {
+		t.Fatal(hex.EncodeToString(b[:32]))
+	}
+	g, _, err = DecodeZetaStandard(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsStandardLayout() || !bytes.Equal(g[8:], h[8:]) {
+		t.Fatal("registers differ")
+	}
+}
+
+// TestZetaStandardRoundTrip checks that the standard layout with ZetaSketch hashes encodes
+// as the default one with remapped hashes.
+func TestZetaStandardRoundTrip(t *testing.T) {
+	for _, p := range []int{10, 14} {
+		s, err := SizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		for _, n := range []int{0, 1, 100, 1000, 100000} {
+			h, std := make(HLL, s), newStandard(p)
+			for i := 0; i < n; i++ {
+				h.Add(RemapZetaHash(xorShift64StarRound(i+1), p))
+				std.Add(xorShift64StarRound(i + 1))
+			}
+			b, err := EncodeZeta(h, ZetaParams{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := EncodeZeta(std, ZetaParams{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, c) {
+				t.Fatal(p, n, "encodings differ")
+			}
+			g, _, err := DecodeZetaStandard(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := g.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if !g.IsStandardLayout() || !bytes.Equal(toDenseHLL(g)[8:], toDenseHLL(std)[8:]) {
+				t.Fatal(p, n, "registers differ")
+			}
+		}
+	}
+}
+
+// TestZetaRealSketches checks the sketches in testdata/zetasketch: HLL_COUNT.INIT (or zetasketch
+// HyperLogLogPlusPlus.serializeToByteArray) output, hex encoded, one per .hex file.
+// They must decode into a valid HLL and encode back byte for byte.
+func TestZetaRealSketches(t *testing.T) {
+	for _, b := range realSketches(t, "zetasketch") {
+		h, params, err := DecodeZeta(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := h.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if c, err := EncodeZeta(h, params); err != nil || !bytes.Equal(c, b) {
+			t.Fatal("round trip differs", err)
+		}
+	}
+}
+
+// realSketches returns the sketches in testdata/dir (see TestZetaRealSketches). Skips the test if there are none.
+func realSketches(t *testing.T, dir string) [][]byte {
+	paths, _ := filepath.Glob(filepath.Join("testdata", dir, "*.hex"))
+	if len(paths) == 0 {
+		t.Skip("no sketches in testdata/" + dir)
+	}
+	var bs [][]byte
+	for _, path := range paths {
+		s, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := hex.DecodeString(strings.Join(strings.Fields(string(s)), ""))
+		if err != nil {
+			t.Fatal(path, err)
+		}
+		bs = append(bs, b)
+	}
+	return bs
+}
+
+func TestZetaRoundTrip(t *testing.T) {
+	for _, p := range []int{10, 14, 24} {
+		s, err := SizeByP(p)
+		if err != nil {
+			log.Panicln(err)
+		}
+		for _, n := range []int{0, 1, 100, 1000, 100000} {
+			if p == 24 && n == 100000 {
+				continue // Slow.
+			}
+			h := make(HLL, s)
+			for i := 0; i < n; i++ {
+				h.Add(xorShift64StarRound(i + 1))
+			}
+			b, err := EncodeZeta(h, ZetaParams{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			g, params, err := DecodeZeta(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if params.NumValues != int64(h.PeekCardinality()) {
+				t.Fatal(params.NumValues)
+			}
+			if err := g.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(toDenseHLL(g)[8:], toDenseHLL(h)[8:]) {
+				t.Fatal(p, n, "registers differ")
+			}
+		}
+	}
+}
+
+func TestZetaSparseValue(t *testing.T) {
+	for _, c := range []struct{ p, sp int }{{10, 15}, {14, 19}, {14, 14}, {20, 25}, {12, 25}} {
+		d := make(Dense, 3<<uint(c.p-2))
+		for i := 0; i < 1000; i++ {
+			x := xorShift64StarRound(i + 1)
+			if i%3 == 0 {
+				x &^= (1<<uint(c.sp-c.p) - 1) << uint(64-c.sp) // Force a flagged value.
+			}
+			v := zetaSparseValue(x, c.p, c.sp)
+			y, err := zetaSparseHash(v, c.p, c.sp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if zetaSparseValue(y, c.p, c.sp) != v {
+				t.Fatal(c, x, y)
+			}
+			ix, rx := d.register(RemapZetaHash(x, c.p))
+			iy, ry := d.register(RemapZetaHash(y, c.p))
+			if ix != iy || rx != ry {
+				t.Fatal(c, x, y)
+			}
+		}
+	}
+}
+
+func TestDecodeZetaErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"08",
+		"0871",                           // Wrong type.
+		"0870",                           // No state.
+		"087082070118",                   // Truncated state.
+		"08708207021809",                 // p = 9.
+		"087082070a180a20092a0400000000", // sp < p, data of wrong size.
+		"0870820708180a200f3203818001",   // Sparse size mismatch.
+		"08708207091002180a200f320281",   // Truncated varint.
+		"0870820709180a200f2a00320100",   // Both representations.
+		"0870820709100118" + "0a200f320280" + "80", // Sparse value out of range after truncation.
+		"0873", // Unsupported wire type.
+	} {
+		b, _ := hex.DecodeString(s)
+		if _, _, err := DecodeZeta(b); err == nil {
+			t.Fatal("expected error for", s)
+		}
+	}
+}